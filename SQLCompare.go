@@ -1,30 +1,65 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
+	"unicode"
 )
 
 type Column struct {
-	Name  string
-	Type  string
-	Other string
+	Name     string
+	Type     string
+	Other    string
+	Ordinal  int
+	Nullable bool
+	Default  string
+	// MySQL 8.0 INVISIBLE columns are left out of SELECT *
+	Invisible bool
+	// allowed values of a SET column, quoted as written
+	SetValues []string
+	// NDB Cluster COLUMN_FORMAT, FIXED, DYNAMIC or DEFAULT, empty when not
+	// set. Only compared with -include-ndb-attrs.
+	ColumnFormat string
 }
 
 type Index struct {
 	Name       string
 	ColumnName string
+	Kind       IndexKind
+	// length of a prefix index, KEY `idx` (`col`(191)), on its last
+	// column, 0 when the whole column is indexed
+	PrefixLength int
+	// MySQL 8.0 functional indexes index expressions instead of columns.
+	// Expression is the whitespace-normalized key part list, also used as
+	// the ColumnName the index is looked up by.
+	IsExpression bool
+	Expression   string
 }
 
+// IndexKind is the kind of a secondary index, empty for a regular B-tree one.
+type IndexKind string
+
+const (
+	IndexKindFulltext IndexKind = "FULLTEXT"
+	IndexKindSpatial  IndexKind = "SPATIAL"
+)
+
 type Constraint struct {
 	Name       string
 	ColumnName string
 	Type       string
 	Other      string
+	MatchType  string
 }
 
 type Table struct {
@@ -32,117 +67,1093 @@ type Table struct {
 	Columns     map[string]Column
 	Indexes     map[string]Index
 	Constraints map[string](map[string]Constraint)
+	// CockroachDB column families by column name and INTERLEAVE IN PARENT
+	Families   map[string]string
+	Interleave string
+	Options    TableOptions
+	// DEFAULT CHARSET of a MySQL table, only linted
+	Charset string
+}
+
+// Schema is everything compared between two schemas: the tables, the views
+// querying them, the triggers on them, the stored procedures and functions
+// and the PostgreSQL sequences.
+type Schema struct {
+	Tables    map[string]Table
+	Views     map[string]View
+	Triggers  map[string]Trigger
+	Routines  map[string]Routine
+	Sequences map[string]Sequence
+}
+
+// TableOptions are the table options after the closing parenthesis of a
+// CREATE TABLE that are compared.
+type TableOptions struct {
+	// ENCRYPTION='Y', changing it rebuilds the table
+	Encrypted bool
+	// STATS_PERSISTENT and STATS_AUTO_RECALC, nil when not set or set to
+	// DEFAULT, leaving them to the innodb_stats_* server variables
+	StatsPersistent *bool
+	StatsAutoRecalc *bool
+	// the other options as written, e.g. ENGINE=InnoDB DEFAULT
+	// CHARSET=utf8mb4, only used to create the table again
+	Other string
+}
+
+// MissingRequiredColumns returns the required column names, in order, that
+// the table does not have.
+func (t Table) MissingRequiredColumns(required []string) []string {
+	var missing []string
+	for _, name := range required {
+		if _, exists := t.Columns[name]; !exists {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// SortedColumns returns the columns in the order they are defined in.
+func (t Table) SortedColumns() []Column {
+	columns := make([]Column, 0, len(t.Columns))
+	for _, column := range t.Columns {
+		columns = append(columns, column)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		if columns[i].Ordinal != columns[j].Ordinal {
+			return columns[i].Ordinal < columns[j].Ordinal
+		}
+		return columns[i].Name < columns[j].Name
+	})
+	return columns
+}
+
+// SortedIndexes returns the indexes sorted by name, then by columns.
+func (t Table) SortedIndexes() []Index {
+	indexes := make([]Index, 0, len(t.Indexes))
+	for _, index := range t.Indexes {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		if indexes[i].Name != indexes[j].Name {
+			return indexes[i].Name < indexes[j].Name
+		}
+		return indexes[i].ColumnName < indexes[j].ColumnName
+	})
+	return indexes
+}
+
+// SortedConstraints returns the primary key first, then the unique keys and
+// the other constraints, each sorted by name.
+func (t Table) SortedConstraints() []Constraint {
+	var constraints []Constraint
+	for _, columnConstraints := range t.Constraints {
+		for _, constraint := range columnConstraints {
+			constraints = append(constraints, constraint)
+		}
+	}
+	rank := func(c Constraint) int {
+		switch c.Type {
+		case "PRIMARY":
+			return 0
+		case "UNIQUE":
+			return 1
+		}
+		return 2
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		a, b := constraints[i], constraints[j]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.ColumnName != b.ColumnName {
+			return a.ColumnName < b.ColumnName
+		}
+		return a.Type < b.Type
+	})
+	return constraints
 }
 
 const (
-	MissingTable         = "MISSING_TABLE"
-	MissingColumn        = "MISSING_COLUMN"
-	WrongColumnType      = "WRONG_COLUMN_TYPE"
-	WrongColumnOther     = "WRONG_COLUMN_OTHER"
-	MissingIndex         = "MISSING_INDEX"
-	MissingConstraint    = "MISSING_CONSTRAINT"
-	WrongConstraintOther = "WRONG_CONSTRAINT_OTHER"
+	MissingTable                    = "MISSING_TABLE"
+	MissingColumn                   = "MISSING_COLUMN"
+	WrongColumnType                 = "WRONG_COLUMN_TYPE"
+	WrongColumnOther                = "WRONG_COLUMN_OTHER"
+	MissingIndex                    = "MISSING_INDEX"
+	MissingConstraint               = "MISSING_CONSTRAINT"
+	WrongConstraintOther            = "WRONG_CONSTRAINT_OTHER"
+	NullableToNotNullWithoutDefault = "NULLABLE_TO_NOT_NULL_WITHOUT_DEFAULT"
+	SensitiveColumnChanged          = "SENSITIVE_COLUMN_CHANGED"
+	WrongFKMatchType                = "WRONG_FK_MATCH_TYPE"
+	RenamedTable                    = "RENAMED_TABLE"
+	RenamedColumn                   = "RENAMED_COLUMN"
+	WrongIndexName                  = "WRONG_INDEX_NAME"
+	WrongIndexPrefixLength          = "WRONG_INDEX_PREFIX_LENGTH"
+	WrongIndexKind                  = "WRONG_INDEX_KIND"
+	WrongColumnFamily               = "WRONG_COLUMN_FAMILY"
+	WrongInterleave                 = "WRONG_INTERLEAVE"
+	WrongSetValues                  = "WRONG_SET_VALUES"
+	WrongColumnInvisibility         = "WRONG_COLUMN_INVISIBILITY"
+	WrongColumnFormat               = "WRONG_COLUMN_FORMAT"
+	WrongTableEncryption            = "WRONG_TABLE_ENCRYPTION"
+	WrongTableStatsOption           = "WRONG_TABLE_STATS_OPTION"
+	MissingView                     = "MISSING_VIEW"
+	WrongViewDefinition             = "WRONG_VIEW_DEFINITION"
+	MissingTrigger                  = "MISSING_TRIGGER"
+	WrongTriggerBody                = "WRONG_TRIGGER_BODY"
+	MissingRoutine                  = "MISSING_ROUTINE"
+	WrongRoutineBody                = "WRONG_ROUTINE_BODY"
+	MissingSequence                 = "MISSING_SEQUENCE"
+	WrongSequenceOption             = "WRONG_SEQUENCE_OPTION"
+	ExtraTable                      = "EXTRA_TABLE"
+	ExtraColumn                     = "EXTRA_COLUMN"
+	ExtraIndex                      = "EXTRA_INDEX"
+	ExtraConstraint                 = "EXTRA_CONSTRAINT"
 )
 
+type ParseError struct {
+	Line    int
+	Content string
+	Reason  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Reason, e.Content)
+}
+
+var diffTypeOrder = []string{
+	MissingTable,
+	RenamedTable,
+	MissingColumn,
+	RenamedColumn,
+	WrongColumnType,
+	WrongSetValues,
+	WrongColumnOther,
+	WrongColumnInvisibility,
+	WrongColumnFormat,
+	NullableToNotNullWithoutDefault,
+	SensitiveColumnChanged,
+	WrongColumnFamily,
+	WrongInterleave,
+	WrongTableEncryption,
+	WrongTableStatsOption,
+	MissingConstraint,
+	WrongConstraintOther,
+	WrongFKMatchType,
+	MissingIndex,
+	WrongIndexKind,
+	WrongIndexPrefixLength,
+	WrongIndexName,
+	MissingView,
+	WrongViewDefinition,
+	MissingTrigger,
+	WrongTriggerBody,
+	MissingRoutine,
+	WrongRoutineBody,
+	MissingSequence,
+	WrongSequenceOption,
+	ExtraTable,
+	ExtraColumn,
+	ExtraConstraint,
+	ExtraIndex,
+}
+
+func isDiffType(diffType string) bool {
+	for _, t := range diffTypeOrder {
+		if t == diffType {
+			return true
+		}
+	}
+	return false
+}
+
 type Diff struct {
-	Type   string
-	Target string
-	A      string
-	B      string
+	Type string `json:"type"`
+	// BREAKING, WARNING or INFO, see classifyDiff
+	Severity string `json:"severity"`
+	Target   string `json:"target"`
+	A        string `json:"a"`
+	B        string `json:"b"`
 }
 
 func main() {
 
-	args := os.Args
-	if len(args) < 2 {
-		log.Fatal("missing first file path")
+	generateMigration := flag.Bool("generate-migration", false, "print the DDL that migrates the first schema into the second instead of the diffs")
+	emitNoOpMigration := flag.Bool("emit-no-op-migration", false, "print SELECT 'No migration needed'; when the schemas are identical, so the migration is never empty")
+	generateRollback := flag.Bool("generate-rollback", false, "print the DDL that migrates the second schema back into the first, same as the revert command")
+	dryRunMigration := flag.Bool("dry-run-migration", false, "check the syntax of the generated migration instead of printing it")
+	dryRun := flag.Bool("dry-run", false, "print the migration, then apply it to the first schema in memory and report any remaining diffs")
+	migrationTool := flag.String("migration-tool", "", "how the migration is applied: empty for plain SQL, percona for pt-online-schema-change")
+	database := flag.String("database", "", "database name used by the percona migration tool")
+	ptMaxLoad := flag.String("pt-max-load", "Threads_running=25", "--max-load passed to pt-online-schema-change")
+	ptCriticalLoad := flag.String("pt-critical-load", "Threads_running=50", "--critical-load passed to pt-online-schema-change")
+	ptCheckSlaveLag := flag.String("pt-check-slave-lag", "", "replica DSN passed to pt-online-schema-change --check-slave-lag")
+	lintSoftDelete := flag.Bool("lint-soft-delete", false, "warn about tables without a soft-delete column")
+	softDeleteColumn := flag.String("soft-delete-column", "deleted_at,is_deleted", "comma-separated column names accepted as soft-delete columns")
+	lintAuditColumns := flag.Bool("lint-audit-columns", false, "warn about tables without audit timestamp columns")
+	auditColumns := flag.String("audit-columns", "created_at,updated_at", "comma-separated audit column names required by -lint-audit-columns")
+	auditColumnsFile := flag.String("audit-columns-file", "", "file listing the audit column names, one per line, overriding -audit-columns")
+	checkIndexCount := flag.Bool("check-index-count", false, "warn about tables with too many indexes")
+	maxIndexes := flag.Int("max-indexes", 10, "number of indexes per table above which -check-index-count warns")
+	requirePrimaryKeys := flag.Bool("require-primary-keys", false, "fail when a table of either schema has no primary key, except the -exclude-tables ones")
+	requireFKIndexes := flag.Bool("require-fk-indexes", false, "warn about foreign key columns without an index")
+	checkUTF8MB4 := flag.Bool("check-utf8mb4", false, "warn about tables and columns with a character set other than utf8mb4, including MySQL's 3-byte utf8")
+	warnLongText := flag.Bool("warn-long-text", false, "suggest a VARCHAR for TEXT and BLOB columns named like short values, e.g. title, name, slug or code")
+	checkNamingPattern := flag.String("check-naming-pattern", "", "file with the naming convention regular expressions for tables, columns, indexes and foreign keys")
+	maxTableNameLength := flag.Int("max-table-name-length", 64, "warn about table names longer than this, 0 to disable")
+	maxColumnNameLength := flag.Int("max-column-name-length", 64, "warn about column names longer than this, 0 to disable")
+	maxIndexNameLength := flag.Int("max-index-name-length", 64, "warn about index and constraint names longer than this, 0 to disable")
+	warnWideTables := flag.Int("warn-wide-tables", 50, "warn about tables with more columns than this, 0 to disable")
+	port := flag.Int("port", 8080, "port sqlcompare serve listens on")
+	batch := flag.String("batch", "", "CSV file of file_a,file_b,label rows, each pair being compared in its own section of one report")
+	score := flag.Bool("score", false, "print a similarity score of the two schemas, from 1.0 when identical to 0.0 when completely different, instead of the diffs")
+	summaryTable := flag.Bool("summary-table", false, "compare every pair of the given schema files and print a matrix of their diff counts")
+	httpTimeout := flag.Duration("http-timeout", 30*time.Second, "timeout for fetching schemas given as http:// or https:// URLs")
+	watch := flag.Bool("watch", false, "re-run the comparison whenever either file changes")
+	noExitCode := flag.Bool("no-exit-code", false, "always exit with 0, even when the schemas differ")
+	failOnBreaking := flag.Bool("fail-on-breaking", false, "exit with 1 only when a diff is BREAKING, not for warnings and info")
+	quiet := flag.Bool("quiet", false, "print nothing, only exit with 1 when the schemas differ")
+	output := flag.String("output", "", "write the output to this file instead of stdout")
+	splitOutput := flag.String("split-output", "", "write the diffs of each table to its own file in this directory instead of printing them")
+	reportFile := flag.String("report-file", "", "write the report to this file like -output and print a one-line summary to stdout")
+	dsnA := flag.String("dsn-a", "", "read the first schema from this live MySQL database, e.g. user:pass@tcp(host:3306)/db, instead of a file")
+	dsnB := flag.String("dsn-b", "", "read the second schema from this live MySQL database instead of a file")
+	metadataQuery := flag.String("metadata-query", "", "read the -dsn-a and -dsn-b schemas with this query, returning table names and JSON definitions, instead of SHOW CREATE TABLE")
+	annotate := flag.String("annotate", "", "write a copy of the first schema file to this path with a comment before each differing definition")
+	format := flag.String("format", "text", "output format: text, side-by-side, json, csv, html, markdown, jira, terraform, ascii, dot or git-diff")
+	strict := flag.Bool("strict", false, "fail on table definitions that cannot be parsed instead of skipping them")
+	schemaPrefix := flag.String("schema-prefix", "", "keep the database prefix of MySQL table names, e.g. mydb.users, and add this one to unqualified tables")
+	dialect := flag.String("dialect", "mysql", "SQL dialect of the schema files: mysql, postgres, sqlserver, cockroachdb or sqlite")
+	normalise := flag.Bool("normalise", false, "rewrite column definitions in a canonical form before comparing, ignoring keyword case, whitespace and attribute order")
+	alembic := flag.Bool("alembic", false, "the schema files are the output of alembic upgrade --sql")
+	stream := flag.Bool("stream", false, "print diffs as soon as they are found, unsorted and unaligned")
+	detectRenames := flag.Bool("detect-renames", false, "report tables and columns missing from the second schema that match new ones as renamed")
+	renameThreshold := flag.Float64("rename-threshold", 0.8, "column name similarity, from 0 to 1, above which -detect-renames matches two tables")
+	includeTables := flag.String("include-tables", "", "comma-separated regular expressions, only the tables matching one of them are compared")
+	excludeTables := flag.String("exclude-tables", "", "comma-separated regular expressions, the tables matching one of them are ignored")
+	excludeColumns := flag.String("exclude-columns", "", "comma-separated regular expressions, the columns matching one of them are ignored")
+	sensitiveColumns := flag.String("sensitive-columns", "", "comma-separated regular expressions matching column names or table.column, columns holding personal data whose type changes and drops are always BREAKING")
+	diffTypes := flag.String("diff-types", "", "comma-separated diff types to report, e.g. MISSING_TABLE,MISSING_COLUMN, all of them when empty")
+	compareColumns := flag.String("compare-columns", "", "comma-separated column fields to compare out of name, type, nullable, default and other, all of them when empty")
+	noTypeAlias := flag.Bool("no-type-alias", false, "compare column type names as written, reporting INT and INTEGER or BOOL and TINYINT(1) as different")
+	ignoreIndexNames := flag.Bool("ignore-index-names", false, "compare indexes and unique keys by their columns only, ignoring their names")
+	// AUTO_INCREMENT=N table counters are never compared, the flag is
+	// accepted for scripts written for tools that do compare them
+	flag.Bool("ignore-auto-increment", false, "accepted for compatibility, AUTO_INCREMENT=N table counters are never compared")
+	additiveOnly := flag.Bool("additive-only", false, "report only the tables, columns, indexes and constraints added in the second schema, ignoring removals and changes")
+	includeNDBAttrs := flag.Bool("include-ndb-attrs", false, "compare the NDB Cluster COLUMN_FORMAT of columns")
+	diffOnlyColumns := flag.Bool("diff-only-columns", false, "report only the column diffs of the tables, leaving out indexes, constraints and the rest")
+	diffOnlyIndexes := flag.Bool("diff-only-indexes", false, "report only the index diffs of the tables, leaving out columns, constraints and the rest")
+	diffOnlyConstraints := flag.Bool("diff-only-constraints", false, "report only the constraint diffs of the tables, leaving out columns, indexes and the rest")
+	noTriggers := flag.Bool("no-triggers", false, "do not compare triggers, for triggers managed separately from the schema")
+	ignoreConstraintNames := flag.Bool("ignore-constraint-names", false, "compare constraints by their type, columns and referenced columns only, ignoring their names")
+	groupBy := flag.String("group-by", "type", "how the text output is grouped: type or table")
+	noColor := flag.Bool("no-color", false, "never colour the text output, even on a terminal")
+
+	// sqlcompare revert a.sql b.sql prints the migration from b.sql back to a.sql
+	// and sqlcompare merge base.sql ours.sql theirs.sql merges two branches,
+	// sqlcompare serve -port 8080 serves the HTTP API
+	revert := len(os.Args) > 1 && os.Args[1] == "revert"
+	merge := len(os.Args) > 1 && os.Args[1] == "merge"
+	serve := len(os.Args) > 1 && os.Args[1] == "serve"
+	if revert || merge || serve {
+		flag.CommandLine.Parse(os.Args[2:])
+	} else {
+		flag.Parse()
+	}
+
+	if path := findConfigFile(); path != "" {
+		if err := applyConfig(flag.CommandLine, path); err != nil {
+			log.Fatal(fmt.Sprintf("error reading config file: %s, %v", path, err))
+		}
 	}
 
-	if len(args) < 3 {
-		log.Fatal("missing second file path")
+	// a schema read from a database takes no file path, the other one does
+	args := flag.Args()
+	var paths [2]string
+	for i, dsn := range []string{*dsnA, *dsnB} {
+		if *batch != "" || serve {
+			break
+		}
+		if dsn != "" {
+			paths[i] = dsnLabel(dsn)
+			continue
+		}
+		if len(args) < 1 {
+			log.Fatal(fmt.Sprintf("missing %s file path", []string{"first", "second"}[i]))
+		}
+		paths[i], args = args[0], args[1:]
 	}
 
-	d1, err := ioutil.ReadFile(args[1])
+	if (*dsnA != "" || *dsnB != "") && *dialect != "mysql" {
+		log.Fatal("-dsn-a and -dsn-b only support the mysql dialect")
+	}
+	if *batch != "" && (*dsnA != "" || *dsnB != "" || *annotate != "" || *watch) {
+		log.Fatal("-batch does not support -dsn-a, -dsn-b, -annotate or -watch")
+	}
+	if *metadataQuery != "" && *dsnA == "" && *dsnB == "" {
+		log.Fatal("-metadata-query needs -dsn-a or -dsn-b")
+	}
+	if (*dsnA != "" || paths[0] == stdinPath) && *annotate != "" {
+		log.Fatal("-annotate needs the first schema to be a file")
+	}
+	if paths[0] == stdinPath && paths[1] == stdinPath {
+		log.Fatal("only one schema can be read from stdin")
+	}
+	if (paths[0] == stdinPath || paths[1] == stdinPath || isURL(paths[0]) || isURL(paths[1])) && *watch {
+		log.Fatal("-watch needs both schemas to be files")
+	}
+	httpClient.Timeout = *httpTimeout
+
+	auditColumnNames := splitList(*auditColumns)
+	if *auditColumnsFile != "" {
+		var err error
+		auditColumnNames, err = readListFile(*auditColumnsFile)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("error reading audit columns file: %s, %v", *auditColumnsFile, err))
+		}
+	}
+
+	var namingPatterns NamingPatterns
+	if *checkNamingPattern != "" {
+		var err error
+		namingPatterns, err = readNamingPatterns(*checkNamingPattern)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("error reading naming pattern file: %s, %v", *checkNamingPattern, err))
+		}
+	}
+
+	compareOpts := CompareOptions{IgnoreIndexNames: *ignoreIndexNames, IgnoreConstraintNames: *ignoreConstraintNames, NoTypeAlias: *noTypeAlias, NoTriggers: *noTriggers, IncludeNDBAttrs: *includeNDBAttrs}
+	var err error
+	compareOpts.IncludeTables, err = compilePatterns(*includeTables)
+	if err != nil {
+		log.Fatal(fmt.Sprintf("invalid -include-tables: %v", err))
+	}
+	compareOpts.ExcludeTables, err = compilePatterns(*excludeTables)
+	if err != nil {
+		log.Fatal(fmt.Sprintf("invalid -exclude-tables: %v", err))
+	}
+	compareOpts.ExcludeColumns, err = compilePatterns(*excludeColumns)
+	if err != nil {
+		log.Fatal(fmt.Sprintf("invalid -exclude-columns: %v", err))
+	}
+	compareOpts.SensitiveColumns, err = compilePatterns(*sensitiveColumns)
 	if err != nil {
-		log.Fatal(fmt.Sprintf("error reading file 1: %s, %v", args[1], err))
+		log.Fatal(fmt.Sprintf("invalid -sensitive-columns: %v", err))
+	}
+
+	if *compareColumns != "" {
+		compareOpts.CompareColumns = make(map[string]bool)
+		for _, field := range splitList(*compareColumns) {
+			field = strings.ToLower(field)
+			if !isColumnField(field) {
+				log.Fatal(fmt.Sprintf("unknown column field in -compare-columns: %s", field))
+			}
+			compareOpts.CompareColumns[field] = true
+		}
+	}
+
+	diffOnly := map[string]bool{"columns": *diffOnlyColumns, "indexes": *diffOnlyIndexes, "constraints": *diffOnlyConstraints}
+	if *diffOnlyColumns || *diffOnlyIndexes || *diffOnlyConstraints {
+		compareOpts.DiffOnly = diffOnly
+	}
+
+	types := make(map[string]bool)
+	for _, diffType := range splitList(*diffTypes) {
+		diffType = strings.ToUpper(diffType)
+		if !isDiffType(diffType) {
+			log.Fatal(fmt.Sprintf("unknown diff type in -diff-types: %s", diffType))
+		}
+		types[diffType] = true
+	}
+
+	if *additiveOnly && *stream {
+		log.Fatal("-additive-only does not support -stream")
+	}
+
+	if *groupBy != "type" && *groupBy != "table" {
+		log.Fatal(fmt.Sprintf("unknown -group-by: %s", *groupBy))
 	}
 
-	d2, err := ioutil.ReadFile(args[2])
+	if *migrationTool == "percona" && *database == "" {
+		log.Fatal("the percona migration tool requires -database")
+	}
+
+	if *reportFile != "" {
+		if *output != "" {
+			log.Fatal("use either -output or -report-file")
+		}
+		*output = *reportFile
+	}
+
+	opts := runOptions{
+		Quiet:             *quiet,
+		Output:            *output,
+		ReportSummary:     *reportFile != "",
+		SplitOutput:       *splitOutput,
+		Annotate:          *annotate,
+		DSNA:              *dsnA,
+		DSNB:              *dsnB,
+		MetadataQuery:     *metadataQuery,
+		Format:            *format,
+		Dialect:           *dialect,
+		Strict:            *strict,
+		SchemaPrefix:      *schemaPrefix,
+		Alembic:           *alembic,
+		Normalise:         *normalise,
+		Stream:            *stream,
+		Compare:           compareOpts,
+		DiffTypes:         types,
+		AdditiveOnly:      *additiveOnly,
+		FailOnBreaking:    *failOnBreaking,
+		GroupBy:           *groupBy,
+		DetectRenames:     *detectRenames,
+		RenameThreshold:   *renameThreshold,
+		Color:             !*noColor && *output == "" && isTerminal(os.Stdout),
+		GenerateMigration: *generateMigration,
+		Revert:            revert || *generateRollback,
+		DryRunMigration:   *dryRunMigration,
+		DryRun:            *dryRun,
+		EmitNoOpMigration: *emitNoOpMigration,
+		MigrationTool:     *migrationTool,
+		Percona: PerconaOptions{
+			Database:      *database,
+			MaxLoad:       *ptMaxLoad,
+			CriticalLoad:  *ptCriticalLoad,
+			CheckSlaveLag: *ptCheckSlaveLag,
+		},
+		Lint: LintOptions{
+			SoftDelete:          *lintSoftDelete,
+			SoftDeleteColumns:   splitList(*softDeleteColumn),
+			AuditColumns:        *lintAuditColumns,
+			AuditColumnNames:    auditColumnNames,
+			IndexCount:          *checkIndexCount,
+			MaxIndexes:          *maxIndexes,
+			FKIndexes:           *requireFKIndexes,
+			LongText:            *warnLongText,
+			UTF8MB4:             *checkUTF8MB4,
+			RequirePrimaryKeys:  *requirePrimaryKeys,
+			ExcludeTables:       compareOpts.ExcludeTables,
+			MaxTableNameLength:  *maxTableNameLength,
+			MaxColumnNameLength: *maxColumnNameLength,
+			MaxIndexNameLength:  *maxIndexNameLength,
+			WideTableColumns:    *warnWideTables,
+			Naming:              namingPatterns,
+		},
+	}
+	opts.Pipeline = defaultPipeline(opts)
+
+	if merge {
+		if len(flag.Args()) != 3 {
+			log.Fatal("merge needs the base, ours and theirs file paths")
+		}
+		conflicts, err := runMerge(opts, flag.Arg(0), flag.Arg(1), flag.Arg(2))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if conflicts {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if serve {
+		log.Fatal(runServer(opts, *port))
+	}
+
+	if *batch != "" {
+		differ, err := runBatch(opts, *batch)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if differ && !*noExitCode {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *score {
+		differ, err := runScore(opts, paths[0], paths[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		if differ && !*noExitCode {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *summaryTable {
+		if len(flag.Args()) < 2 {
+			log.Fatal("-summary-table needs at least two schema files")
+		}
+		differ, err := runSummaryTable(opts, flag.Args())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if differ && !*noExitCode {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *watch {
+		watchFiles([]string{paths[0], paths[1]}, watchInterval, func() {
+			fmt.Print(clearScreen)
+			fmt.Printf("%s\n", time.Now().Format("2006-01-02 15:04:05"))
+			if _, err := run(opts, paths[0], paths[1]); err != nil {
+				log.Print(err)
+			}
+		})
+		return
+	}
+
+	differ, err := run(opts, paths[0], paths[1])
 	if err != nil {
-		log.Fatal(fmt.Sprintf("error reading file 2: %s, %v", args[2], err))
+		log.Fatal(err)
 	}
 
-	dataA := string(d1)
-	dataB := string(d2)
+	if differ && !*noExitCode {
+		os.Exit(1)
+	}
+}
 
-	tablesA := parseTables(dataA)
-	tablesB := parseTables(dataB)
+type runOptions struct {
+	Quiet             bool
+	Output            string
+	ReportSummary     bool
+	SplitOutput       string
+	Annotate          string
+	DSNA              string
+	DSNB              string
+	MetadataQuery     string
+	Format            string
+	Dialect           string
+	Strict            bool
+	SchemaPrefix      string
+	Alembic           bool
+	Normalise         bool
+	Stream            bool
+	Compare           CompareOptions
+	DiffTypes         map[string]bool
+	AdditiveOnly      bool
+	FailOnBreaking    bool
+	GroupBy           string
+	DetectRenames     bool
+	RenameThreshold   float64
+	Color             bool
+	GenerateMigration bool
+	Revert            bool
+	DryRunMigration   bool
+	DryRun            bool
+	EmitNoOpMigration bool
+	MigrationTool     string
+	Percona           PerconaOptions
+	Lint              LintOptions
+	// the stages of the comparison, nil ones being the built-in stages
+	Pipeline Pipeline
+}
+
+// run compares the two schema files and reports whether they differ.
+func run(opts runOptions, pathA string, pathB string) (bool, error) {
+
+	var w io.Writer = os.Stdout
+	if opts.Quiet {
+		w = ioutil.Discard
+	}
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return false, fmt.Errorf("error creating output file: %s, %v", opts.Output, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	return compareFiles(w, opts, pathA, pathB)
+}
+
+// compareFiles compares the two schema files, writing the report to w, and
+// reports whether they differ.
+func compareFiles(w io.Writer, opts runOptions, pathA string, pathB string) (bool, error) {
+
+	pipeline := opts.pipeline()
+
+	schemaA, parseErrorsA, err := parseSource(pathA, opts.DSNA, opts)
+	if err != nil {
+		return false, fmt.Errorf("error reading file 1: %s, %v", pathA, err)
+	}
+
+	schemaB, parseErrorsB, err := parseSource(pathB, opts.DSNB, opts)
+	if err != nil {
+		return false, fmt.Errorf("error reading file 2: %s, %v", pathB, err)
+	}
+	tablesA, tablesB := schemaA.Tables, schemaB.Tables
 
 	//	printTables(tablesA)
 	//printTables(tablesB)
 
-	diffs := compareTables(tablesA, tablesB)
-	diffs = groupByType(diffs)
+	if opts.GenerateMigration || opts.Revert || opts.DryRunMigration || opts.DryRun {
+		migration := buildMigration(tablesA, tablesB, opts.Compare)
+		if opts.Revert {
+			migration = generateRollback(tablesA, tablesB, opts.Compare)
+		}
+
+		if opts.DryRunMigration {
+			errs := dryRunMigration(migration)
+			for _, err := range errs {
+				fmt.Fprintln(w, err)
+			}
+			if len(errs) > 0 {
+				return false, fmt.Errorf("%d invalid migration statements", len(errs))
+			}
+			fmt.Fprintf(w, "%d migration statements are valid\n", len(migrationStatements(migration)))
+			return false, nil
+		}
+
+		switch opts.MigrationTool {
+		case "":
+			sql := renderMigrationSQL(migration)
+			if sql == "" && opts.EmitNoOpMigration {
+				sql = noOpMigration
+			}
+			fmt.Fprint(w, sql)
+		case "percona":
+			fmt.Fprint(w, renderPerconaMigration(migration, opts.Percona))
+		default:
+			return false, fmt.Errorf("unknown migration tool: %s", opts.MigrationTool)
+		}
+
+		if opts.DryRun {
+			// the migration must turn the old schema into exactly the new one
+			from, to := tablesA, tablesB
+			if opts.Revert {
+				from, to = tablesB, tablesA
+			}
+			applied, err := applyMigration(from, migration)
+			if err != nil {
+				return false, fmt.Errorf("error applying the migration: %v", err)
+			}
+			residual := groupByType(append(compareTables(applied, to, opts.Compare), compareTables(to, applied, opts.Compare)...))
+			if len(residual) == 0 {
+				fmt.Fprintln(w, "\n-- Migration is complete and self-consistent")
+				return false, nil
+			}
+			fmt.Fprintln(w)
+			for _, d := range residual {
+				fmt.Fprintf(w, "-- WARNING: %s %s: %s vs %s\n", d.Type, d.Target, annotationValue(d.A), annotationValue(d.B))
+			}
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if opts.Stream {
+		if opts.Format != "text" {
+			return false, fmt.Errorf("-stream only supports the text format")
+		}
+
+		stream := make(chan Diff)
+		go CompareTablesStreaming(tablesA, tablesB, opts.Compare, stream)
+
+		filtered := make(chan Diff)
+		go func() {
+			defer close(filtered)
+			for d := range stream {
+				if keepDiff(opts.DiffTypes, d) {
+					filtered <- d
+				}
+			}
+		}()
+		count := printDiffStream(w, filtered, pathA, pathB)
+		return count > 0, nil
+	}
+
+	diffs := pipeline.Compare.Compare(pipeline.Filter.Filter(schemaA), pipeline.Filter.Filter(schemaB))
+	diffs = filterDiffTypes(diffs, opts.DiffTypes)
+
+	if opts.Annotate != "" {
+		data, err := readInput(pathA)
+		if err != nil {
+			return false, fmt.Errorf("error reading file 1: %s, %v", pathA, err)
+		}
+		annotated := annotateSchema(string(data), diffs, tablesA, opts.SchemaPrefix)
+		if err := ioutil.WriteFile(opts.Annotate, []byte(annotated), 0644); err != nil {
+			return false, fmt.Errorf("error writing annotated schema: %s, %v", opts.Annotate, err)
+		}
+	}
+
+	if opts.SplitOutput != "" {
+		if err := writeSplitOutput(diffs, opts.SplitOutput, opts.Format); err != nil {
+			return false, fmt.Errorf("error writing split output: %s, %v", opts.SplitOutput, err)
+		}
+		return len(diffs) > 0, nil
+	}
+
+	lintA := lintTables(tablesA, opts.Lint)
+	lintB := lintTables(tablesB, opts.Lint)
+
+	err = pipeline.Format.Format(w, Report{
+		PathA:        pathA,
+		PathB:        pathB,
+		SchemaA:      schemaA,
+		SchemaB:      schemaB,
+		Diffs:        diffs,
+		LintA:        lintA,
+		LintB:        lintB,
+		ParseErrorsA: parseErrorsA,
+		ParseErrorsB: parseErrorsB,
+	})
+	if err == nil && opts.ReportSummary && !opts.Quiet {
+		fmt.Println(reportSummary(diffs, opts.Output))
+	}
 
-	printDiffs(diffs, args[1], args[2])
+	// the text, side-by-side and JSON formats list the tables with the other
+	// lint warnings
+	missingPrimaryKeys := hasRule(lintA, MissingPrimaryKey) || hasRule(lintB, MissingPrimaryKey)
+	if missingPrimaryKeys && opts.Format != "text" && opts.Format != "side-by-side" && opts.Format != "json" && !opts.Quiet {
+		for i, warnings := range [][]LintWarning{lintA, lintB} {
+			path := []string{pathA, pathB}[i]
+			for _, warning := range warnings {
+				if warning.Rule == MissingPrimaryKey {
+					fmt.Fprintf(os.Stderr, "%s: table %s has no primary key\n", path, warning.Target)
+				}
+			}
+		}
+	}
+
+	if opts.FailOnBreaking {
+		return hasBreaking(diffs) || missingPrimaryKeys, err
+	}
+	return len(diffs) > 0 || missingPrimaryKeys, err
 }
 
-func groupByType(ds []Diff) []Diff {
+// parseFile parses and normalizes a schema file with the pipeline of opts.
+// The definitions the parser skipped are returned alongside the tables.
+func parseFile(path string, opts runOptions) (map[string]Table, []ParseError, error) {
+	schema, parseErrors, err := parseSource(path, "", opts)
+	return schema.Tables, parseErrors, err
+}
 
-	missingTable := make([]Diff, 0)
-	missingColumn := make([]Diff, 0)
-	wrongColumnType := make([]Diff, 0)
-	wrongColumnOther := make([]Diff, 0)
-	missingIndex := make([]Diff, 0)
-	missingConstraint := make([]Diff, 0)
-	wrongConstraintOther := make([]Diff, 0)
+// parseSource parses and normalizes the live MySQL database at dsn when it
+// is set, and the file at path otherwise. With a metadata query the
+// database holds the schema as data instead of as tables, and a .yml or
+// .yaml file is read with ParseYAMLSchema, so both skip the parse stage.
+func parseSource(path string, dsn string, opts runOptions) (Schema, []ParseError, error) {
 
-	for _, d := range ds {
+	pipeline := opts.pipeline()
 
-		switch d.Type {
-		case MissingTable:
-			missingTable = append(missingTable, d)
-		case MissingColumn:
-			missingColumn = append(missingColumn, d)
-		case WrongColumnType:
-			wrongColumnType = append(wrongColumnType, d)
-		case WrongColumnOther:
-			wrongColumnOther = append(wrongColumnOther, d)
-		case MissingIndex:
-			missingIndex = append(missingIndex, d)
-		case MissingConstraint:
-			missingConstraint = append(missingConstraint, d)
-		case WrongConstraintOther:
-			wrongConstraintOther = append(wrongConstraintOther, d)
-		}
-	}
-
-	slices := [][]Diff{
-		missingTable,
-		missingColumn,
-		wrongColumnType,
-		wrongColumnOther,
-		missingConstraint,
-		wrongConstraintOther,
-		missingIndex,
+	var r io.Reader
+	switch {
+	case dsn == "" && isYAMLSchema(path):
+		bytes, err := readInput(path)
+		if err != nil {
+			return Schema{}, nil, err
+		}
+		tables, err := ParseYAMLSchema(bytes)
+		if err != nil {
+			return Schema{}, nil, err
+		}
+		return pipeline.Normalize.Normalize(Schema{Tables: tables}), nil, nil
+	case dsn == "":
+		f, err := openInput(path)
+		if err != nil {
+			return Schema{}, nil, err
+		}
+		defer f.Close()
+		r = f
+	case opts.MetadataQuery != "":
+		tables, err := readMetadataSchema(dsn, opts.MetadataQuery)
+		if err != nil {
+			return Schema{}, nil, err
+		}
+		return pipeline.Normalize.Normalize(Schema{Tables: tables}), nil, nil
+	default:
+		data, err := readDatabaseSchema(dsn)
+		if err != nil {
+			return Schema{}, nil, err
+		}
+		r = strings.NewReader(data)
+	}
+
+	schema, parseErrors, err := pipeline.Parse.Parse(r)
+	if err != nil {
+		return Schema{}, nil, err
+	}
+	return pipeline.Normalize.Normalize(schema), parseErrors, nil
+}
+
+// parseSchema parses the tables of a schema in the dialect of opts, other
+// than MySQL whose dumps parseMySQLSchema reads as a stream.
+func parseSchema(schema string, opts runOptions) (map[string]Table, error) {
+
+	// dumps made on Windows end their lines with \r\n and some editors
+	// start UTF-8 files with a byte order mark
+	schema = strings.ReplaceAll(schema, "\r\n", "\n")
+	schema = strings.TrimPrefix(schema, "\xef\xbb\xbf")
+	if opts.Alembic {
+		schema = stripAlembic(schema)
+	}
+
+	var tables map[string]Table
+	var err error
+	switch opts.Dialect {
+	case "postgres":
+		tables, err = ParsePostgresDump(schema)
+	case "sqlserver":
+		tables, err = ParseSQLServerDump(schema)
+	case "cockroachdb":
+		tables, err = ParseCockroachDBDump(schema)
+	case "sqlite":
+		tables, err = ParseSQLiteDump(schema)
+	default:
+		err = fmt.Errorf("unknown dialect: %s", opts.Dialect)
+	}
+	return tables, err
+}
+
+func groupByType(ds []Diff) []Diff {
+
+	groups := make(map[string][]Diff)
+	for _, d := range ds {
+		groups[d.Type] = append(groups[d.Type], d)
 	}
 
 	var res []Diff
-	for _, slice := range slices {
-		res = append(res, slice...)
+	for _, diffType := range diffTypeOrder {
+		sortDiffs(groups[diffType])
+		res = append(res, groups[diffType]...)
+	}
+	return res
+}
+
+// filterDiffTypes keeps the diffs whose type is in types, or all of them
+// when types is empty.
+func filterDiffTypes(ds []Diff, types map[string]bool) []Diff {
+	if len(types) == 0 {
+		return ds
+	}
+
+	res := make([]Diff, 0, len(ds))
+	for _, d := range ds {
+		if keepDiff(types, d) {
+			res = append(res, d)
+		}
 	}
 	return res
 }
 
-func compareTables(tableMapA map[string]Table, tableMapB map[string]Table) []Diff {
+func keepDiff(types map[string]bool, d Diff) bool {
+	return len(types) == 0 || types[d.Type]
+}
+
+// groupByTable splits diffs by the table they belong to, keeping their order
+// within each table.
+func groupByTable(ds []Diff) map[string][]Diff {
+	groups := make(map[string][]Diff)
+	for _, d := range ds {
+		tableName, _ := splitTarget(d.Target)
+		groups[tableName] = append(groups[tableName], d)
+	}
+	return groups
+}
+
+func sortDiffs(ds []Diff) {
+	sort.Slice(ds, func(i, j int) bool {
+		if ds[i].Target != ds[j].Target {
+			return ds[i].Target < ds[j].Target
+		}
+		if ds[i].A != ds[j].A {
+			return ds[i].A < ds[j].A
+		}
+		return ds[i].B < ds[j].B
+	})
+}
+
+// CompareOptions restricts what compareTables looks at.
+type CompareOptions struct {
+	IncludeTables  []*regexp.Regexp
+	ExcludeTables  []*regexp.Regexp
+	ExcludeColumns []*regexp.Regexp
+	// compare indexes and unique keys by their columns only
+	IgnoreIndexNames bool
+	// compare constraints by their type, columns and definition only
+	IgnoreConstraintNames bool
+	// column fields compared, out of columnFields, all of them when nil
+	CompareColumns map[string]bool
+	// compare type names as written, INT and INTEGER being different
+	NoTypeAlias bool
+	// leave triggers out, for triggers managed separately
+	NoTriggers bool
+	// compare the NDB Cluster column attributes too
+	IncludeNDBAttrs bool
+	// columns holding personal data, matched by name or table.column, whose
+	// type changes and drops are also reported as SENSITIVE_COLUMN_CHANGED
+	SensitiveColumns []*regexp.Regexp
+	// parts of the tables compared, out of "columns", "indexes" and
+	// "constraints", everything when nil
+	DiffOnly map[string]bool
+}
+
+var columnFields = []string{"name", "type", "nullable", "default", "other"}
+
+func isColumnField(field string) bool {
+	for _, f := range columnFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// sameType reports whether two column types are the same, synonyms like INT
+// and INTEGER or BOOL and TINYINT(1) included unless NoTypeAlias is set.
+func (opts CompareOptions) sameType(typeA string, typeB string) bool {
+	if opts.NoTypeAlias {
+		return typeA == typeB
+	}
+	return aliasedType(typeA) == aliasedType(typeB)
+}
+
+func (opts CompareOptions) comparesColumn(field string) bool {
+	return opts.CompareColumns == nil || opts.CompareColumns[field]
+}
+
+// isSensitive reports whether a column matches -sensitive-columns, by its
+// name or as table.column.
+func (opts CompareOptions) isSensitive(tableName string, columnName string) bool {
+	return matchesAny(opts.SensitiveColumns, columnName) || matchesAny(opts.SensitiveColumns, tableName+"."+columnName)
+}
+
+// comparesPart reports whether the columns, indexes or constraints of the
+// tables are compared. The table options, views, triggers, routines and
+// sequences are only compared when no -diff-only-* flag is set, which
+// comparesPart("") reports.
+func (opts CompareOptions) comparesPart(part string) bool {
+	return opts.DiffOnly == nil || opts.DiffOnly[part]
+}
+
+func compareTables(tableMapA map[string]Table, tableMapB map[string]Table, opts CompareOptions) []Diff {
 
 	diffs := make([]Diff, 0)
+	walkDiffs(tableMapA, tableMapB, opts, func(d Diff) {
+		diffs = append(diffs, d)
+	})
 
-	for _, tableA := range tableMapA {
+	return diffs
+}
+
+// compareAdditions returns the tables, columns, indexes and constraints only
+// present in tableMapB, as EXTRA_* diffs with the name of the new object in B.
+func compareAdditions(tableMapA map[string]Table, tableMapB map[string]Table, opts CompareOptions) []Diff {
+
+	extraTypes := map[string]string{
+		MissingTable:      ExtraTable,
+		MissingColumn:     ExtraColumn,
+		MissingIndex:      ExtraIndex,
+		MissingConstraint: ExtraConstraint,
+	}
+
+	diffs := make([]Diff, 0)
+	for _, d := range compareTables(tableMapB, tableMapA, opts) {
+		extraType, isAddition := extraTypes[d.Type]
+		if !isAddition {
+			continue
+		}
+		extra := Diff{Type: extraType, Target: d.Target, A: d.B, B: d.A}
+		extra.Severity = classifyDiff(extra)
+		if extraType == ExtraColumn {
+			// existing rows cannot get a value for a NOT NULL column
+			column := tableMapB[d.Target].Columns[d.A]
+			if !column.Nullable && column.Default == "" && findWord(column.Other, "AUTO_INCREMENT") < 0 {
+				extra.Severity = SeverityBreaking
+			}
+		}
+		diffs = append(diffs, extra)
+	}
+	return diffs
+}
+
+// filterTables keeps the tables matching one of the include patterns, if
+// any, and none of the exclude patterns.
+func filterTables(tables map[string]Table, opts CompareOptions) map[string]Table {
+	if len(opts.IncludeTables) == 0 && len(opts.ExcludeTables) == 0 {
+		return tables
+	}
+
+	res := make(map[string]Table, len(tables))
+	for name, table := range tables {
+		if includesTable(name, opts) {
+			res[name] = table
+		}
+	}
+	return res
+}
+
+func includesTable(name string, opts CompareOptions) bool {
+	if len(opts.IncludeTables) > 0 && !matchesAny(opts.IncludeTables, name) {
+		return false
+	}
+	return !matchesAny(opts.ExcludeTables, name)
+}
+
+func matchesAny(patterns []*regexp.Regexp, s string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// compilePatterns compiles a comma-separated list of regular expressions,
+// each one anchored to match whole names.
+func compilePatterns(list string) ([]*regexp.Regexp, error) {
+	var patterns []*regexp.Regexp
+	for _, item := range splitList(list) {
+		pattern, err := regexp.Compile("^(?:" + item + ")$")
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// CompareTablesStreaming sends every diff to out as soon as it is found and
+// closes out once the comparison is complete.
+func CompareTablesStreaming(tableMapA map[string]Table, tableMapB map[string]Table, opts CompareOptions, out chan<- Diff) {
+	defer close(out)
+
+	walkDiffs(tableMapA, tableMapB, opts, func(d Diff) {
+		out <- d
+	})
+}
+
+func walkDiffs(tableMapA map[string]Table, tableMapB map[string]Table, opts CompareOptions, emit func(Diff)) {
+
+	tableMapA = filterTables(tableMapA, opts)
+	tableMapB = filterTables(tableMapB, opts)
+
+	report := emit
+	emit = func(d Diff) {
+		d.Severity = classifyDiff(d)
+		report(d)
+	}
+
+	tableNames := make([]string, 0, len(tableMapA))
+	for name := range tableMapA {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	for _, tableName := range tableNames {
+		tableA := tableMapA[tableName]
 
 		tableB, tableExists := tableMapB[tableA.Name]
 		if !tableExists {
-			diffs = append(diffs, Diff{
+			emit(Diff{
 				Type:   MissingTable,
 				Target: tableA.Name,
 				A:      tableA.Name,
@@ -151,88 +1162,329 @@ func compareTables(tableMapA map[string]Table, tableMapB map[string]Table) []Dif
 			continue
 		}
 
-		for _, columnA := range tableA.Columns {
+		if opts.comparesPart("") {
+			if tableA.Interleave != tableB.Interleave {
+				emit(Diff{
+					Type:   WrongInterleave,
+					Target: tableA.Name,
+					A:      tableA.Interleave,
+					B:      tableB.Interleave,
+				})
+			}
+
+			if tableA.Options.Encrypted != tableB.Options.Encrypted {
+				emit(Diff{
+					Type:   WrongTableEncryption,
+					Target: tableA.Name,
+					A:      encryptionOption(tableA.Options),
+					B:      encryptionOption(tableB.Options),
+				})
+			}
+
+			if !sameStatsOption(tableA.Options.StatsPersistent, tableB.Options.StatsPersistent) {
+				emit(Diff{
+					Type:   WrongTableStatsOption,
+					Target: tableA.Name,
+					A:      statsOption("STATS_PERSISTENT", tableA.Options.StatsPersistent),
+					B:      statsOption("STATS_PERSISTENT", tableB.Options.StatsPersistent),
+				})
+			}
+
+			if !sameStatsOption(tableA.Options.StatsAutoRecalc, tableB.Options.StatsAutoRecalc) {
+				emit(Diff{
+					Type:   WrongTableStatsOption,
+					Target: tableA.Name,
+					A:      statsOption("STATS_AUTO_RECALC", tableA.Options.StatsAutoRecalc),
+					B:      statsOption("STATS_AUTO_RECALC", tableB.Options.StatsAutoRecalc),
+				})
+			}
+		}
+
+		columns := tableA.SortedColumns()
+		if !opts.comparesPart("columns") {
+			columns = nil
+		}
+		for _, columnA := range columns {
+
+			if matchesAny(opts.ExcludeColumns, columnA.Name) {
+				continue
+			}
 
 			columnB, columnExists := tableB.Columns[columnA.Name]
 			if !columnExists {
-				diffs = append(diffs, Diff{
+				if !opts.comparesColumn("name") {
+					continue
+				}
+				emit(Diff{
 					Type:   MissingColumn,
 					Target: tableA.Name,
 					A:      columnA.Name,
 					B:      "",
 				})
+				if opts.isSensitive(tableA.Name, columnA.Name) {
+					emit(Diff{
+						Type:   SensitiveColumnChanged,
+						Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+						A:      columnA.Type,
+						B:      "",
+					})
+				}
 				continue
 			}
 
-			if columnA.Type != columnB.Type {
-				diffs = append(diffs, Diff{
+			compareType := opts.comparesColumn("type")
+			if compareType && columnA.SetValues != nil && columnB.SetValues != nil {
+				// SET values are compared as sets, their order is ignored
+				removed, added := subtractValues(columnA.SetValues, columnB.SetValues), subtractValues(columnB.SetValues, columnA.SetValues)
+				if len(removed) > 0 || len(added) > 0 {
+					emit(Diff{
+						Type:   WrongSetValues,
+						Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+						A:      strings.Join(removed, ","),
+						B:      strings.Join(added, ","),
+					})
+				}
+			} else if compareType && !opts.sameType(columnA.Type, columnB.Type) {
+				emit(Diff{
 					Type:   WrongColumnType,
 					Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
 					A:      columnA.Type,
 					B:      columnB.Type,
 				})
+				if opts.isSensitive(tableA.Name, columnA.Name) {
+					emit(Diff{
+						Type:   SensitiveColumnChanged,
+						Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+						A:      columnA.Type,
+						B:      columnB.Type,
+					})
+				}
 			}
 
-			if columnA.Other != columnB.Other {
-				diffs = append(diffs, Diff{
-					Type:   WrongColumnOther,
+			if opts.comparesColumn("other") {
+				if columnA.Other != columnB.Other {
+					emit(Diff{
+						Type:   WrongColumnOther,
+						Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+						A:      columnA.Other,
+						B:      columnB.Other,
+					})
+				}
+			} else {
+				// without the other attributes, nullability and the default
+				// can still be compared on their own
+				if opts.comparesColumn("nullable") && columnA.Nullable != columnB.Nullable {
+					emit(Diff{
+						Type:   WrongColumnOther,
+						Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+						A:      nullability(columnA),
+						B:      nullability(columnB),
+					})
+				}
+				if opts.comparesColumn("default") && columnA.Default != columnB.Default {
+					emit(Diff{
+						Type:   WrongColumnOther,
+						Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+						A:      defaultClause(columnA),
+						B:      defaultClause(columnB),
+					})
+				}
+			}
+
+			if opts.comparesColumn("other") && columnA.Invisible != columnB.Invisible {
+				emit(Diff{
+					Type:   WrongColumnInvisibility,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+					A:      visibility(columnA),
+					B:      visibility(columnB),
+				})
+			}
+
+			if opts.IncludeNDBAttrs && opts.comparesColumn("other") && columnFormat(columnA) != columnFormat(columnB) {
+				emit(Diff{
+					Type:   WrongColumnFormat,
 					Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
-					A:      columnA.Other,
-					B:      columnB.Other,
+					A:      columnFormat(columnA),
+					B:      columnFormat(columnB),
+				})
+			}
+
+			if opts.comparesColumn("other") && tableA.Families[columnA.Name] != tableB.Families[columnA.Name] {
+				emit(Diff{
+					Type:   WrongColumnFamily,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+					A:      tableA.Families[columnA.Name],
+					B:      tableB.Families[columnA.Name],
+				})
+			}
+
+			if opts.comparesColumn("nullable") && columnA.Nullable && !columnB.Nullable && columnB.Default == "" {
+				emit(Diff{
+					Type:   NullableToNotNullWithoutDefault,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, columnA.Name),
+					A:      "NULL",
+					B:      "NOT NULL",
 				})
 			}
 		}
 
-		for _, indexA := range tableA.Indexes {
+		indexes := tableA.SortedIndexes()
+		if !opts.comparesPart("indexes") {
+			indexes = nil
+		}
+		for _, indexA := range indexes {
 
-			_, indexExists := tableB.Indexes[indexA.ColumnName]
+			indexB, indexExists := tableB.Indexes[indexA.ColumnName]
 			if !indexExists {
-				diffs = append(diffs, Diff{
+				emit(Diff{
 					Type:   MissingIndex,
 					Target: fmt.Sprintf("%s.%s", tableA.Name, indexA.ColumnName),
 					A:      indexA.Name,
 					B:      "",
 				})
+				continue
 			}
-		}
 
-		for columnNameA, columnsWithConstraintsA := range tableA.Constraints {
+			if !opts.IgnoreIndexNames && indexA.Name != indexB.Name {
+				emit(Diff{
+					Type:   WrongIndexName,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, indexA.ColumnName),
+					A:      indexA.Name,
+					B:      indexB.Name,
+				})
+			}
 
-			for constraintTypeA, constraintA := range columnsWithConstraintsA {
+			if indexA.Kind != indexB.Kind {
+				emit(Diff{
+					Type:   WrongIndexKind,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, indexA.ColumnName),
+					A:      indexKindLabel(indexA.Kind),
+					B:      indexKindLabel(indexB.Kind),
+				})
+			}
 
-				constraintB, exists := tableB.Constraints[columnNameA][constraintTypeA]
-				if !exists {
-					diffs = append(diffs, Diff{
-						Type:   MissingConstraint,
-						Target: fmt.Sprintf("%s.%s", tableA.Name, columnNameA),
-						A:      constraintA.Type,
-						B:      "",
-					})
-					continue
-				}
+			if indexA.PrefixLength != indexB.PrefixLength {
+				emit(Diff{
+					Type:   WrongIndexPrefixLength,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, indexA.ColumnName),
+					A:      prefixLengthLabel(indexA.PrefixLength),
+					B:      prefixLengthLabel(indexB.PrefixLength),
+				})
+			}
+		}
 
-				if constraintA.Other != constraintB.Other {
-					diffs = append(diffs, Diff{
-						Type:   WrongConstraintOther,
-						Target: fmt.Sprintf("%s.%s.%s", tableA.Name, columnNameA, constraintA.Type),
-						A:      constraintA.Other,
-						B:      constraintB.Other,
-					})
-				}
+		constraints := tableA.SortedConstraints()
+		if !opts.comparesPart("constraints") {
+			constraints = nil
+		}
+		for _, constraintA := range constraints {
+			columnNameA, constraintTypeA := constraintA.ColumnName, constraintA.Type
+
+			constraintB, exists := tableB.Constraints[columnNameA][constraintTypeA]
+			if !exists {
+				emit(Diff{
+					Type:   MissingConstraint,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, columnNameA),
+					A:      constraintA.Type,
+					B:      "",
+				})
+				continue
+			}
 
+			ignoreName := opts.IgnoreIndexNames || opts.IgnoreConstraintNames
+			if constraintTypeA == "UNIQUE" && !ignoreName && constraintA.Name != constraintB.Name {
+				emit(Diff{
+					Type:   WrongIndexName,
+					Target: fmt.Sprintf("%s.%s", tableA.Name, columnNameA),
+					A:      constraintA.Name,
+					B:      constraintB.Name,
+				})
+			}
+
+			if constraintA.Other != constraintB.Other {
+				emit(Diff{
+					Type:   WrongConstraintOther,
+					Target: fmt.Sprintf("%s.%s.%s", tableA.Name, columnNameA, constraintA.Type),
+					A:      constraintA.Other,
+					B:      constraintB.Other,
+				})
+			}
+
+			// InnoDB parses and ignores MATCH, so on MySQL this is cosmetic
+			if constraintA.MatchType != constraintB.MatchType {
+				emit(Diff{
+					Type:   WrongFKMatchType,
+					Target: fmt.Sprintf("%s.%s.%s", tableA.Name, columnNameA, constraintA.Type),
+					A:      constraintA.MatchType,
+					B:      constraintB.MatchType,
+				})
 			}
 		}
 	}
+}
 
-	return diffs
+const maxLineSize = 16 * 1024 * 1024
+
+// mysqlTableName returns the name of a table as written in CREATE TABLE,
+// e.g. `mydb`.`users`, without quotes. The database prefix is dropped unless
+// schemaPrefix is set, in which case unqualified tables get it.
+func mysqlTableName(identifier string, schemaPrefix string) string {
+	parts := splitTopLevel(identifier, '.')
+	name := strings.Trim(parts[len(parts)-1], "`")
+	if schemaPrefix == "" {
+		return name
+	}
+	if len(parts) > 1 {
+		return strings.Trim(parts[0], "`") + "." + name
+	}
+	return schemaPrefix + "." + name
+}
+
+// parseTables parses a MySQL dump. Definitions inside a CREATE TABLE that
+// cannot be parsed are skipped, unless strict is set, in which case the
+// first one is returned as a *ParseError.
+func parseTables(r io.Reader, strict bool, schemaPrefix string) (map[string]Table, []ParseError, error) {
+	schema, parseErrors, err := parseMySQLSchema(r, strict, schemaPrefix, false)
+	return schema.Tables, parseErrors, err
+}
+
+// ParseTablesWithErrors parses a MySQL dump like the default comparison does.
+// The definitions inside a CREATE TABLE that cannot be parsed are skipped
+// and returned as ParseErrors, so a half-parsed file can be told apart from
+// a file without differences.
+func ParseTablesWithErrors(r io.Reader) (map[string]Table, []ParseError, error) {
+	schema, parseErrors, err := parseMySQLSchema(r, false, "", false)
+	return schema.Tables, parseErrors, err
 }
 
-func parseTables(data string) map[string]Table {
+// parseMySQLSchema parses the tables, views, triggers and routines of a
+// MySQL dump as it is read, like parseTables. With alembic set the dump is
+// the output of alembic upgrade --sql.
+func parseMySQLSchema(r io.Reader, strict bool, schemaPrefix string, alembic bool) (Schema, []ParseError, error) {
 	var table Table
 	tables := make(map[string]Table)
 	var analyzingTable bool
 
-	keywords := []string{"PRIMARY", "KEY", "CONSTRAINT", "UNIQUE"}
+	// the statements outside CREATE TABLE blocks hold the views, triggers
+	// and routines
+	schema := Schema{
+		Tables:   tables,
+		Views:    make(map[string]View),
+		Triggers: make(map[string]Trigger),
+		Routines: make(map[string]Routine),
+	}
+	var statements mysqlStatementSplitter
+	addStatement := func(statement string) {
+		if view, ok := parseView(statement, schemaPrefix); ok {
+			schema.Views[view.Name] = view
+		} else if trigger, ok := parseTrigger(statement, schemaPrefix); ok {
+			schema.Triggers[trigger.Name] = trigger
+		} else if routine, ok := parseRoutine(statement, schemaPrefix); ok {
+			schema.Routines[routineKey(routine)] = routine
+		}
+	}
+
+	keywords := []string{"PRIMARY", "KEY", "INDEX", "FULLTEXT", "SPATIAL", "CONSTRAINT", "UNIQUE"}
 	isKeyword := func(str string) bool {
 		for _, v := range keywords {
 			if str == v || str == "" || str == "--" {
@@ -243,60 +1495,144 @@ func parseTables(data string) map[string]Table {
 		return false
 	}
 
-	res := strings.Split(data, "\n")
-	for _, value := range res {
+	unsupported := []string{"CHECK", "FOREIGN"}
+	isUnsupported := func(str string) bool {
+		for _, v := range unsupported {
+			if str == v {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	lineNumber := 0
+	var value string
+	var parseErrors []ParseError
+	parseError := func(reason string) {
+		parseErrors = append(parseErrors, ParseError{Line: lineNumber, Content: value, Reason: reason})
+	}
+
+	scanner := newDefinitionScanner(r, alembic)
+	for scanner.Scan() {
+		lineNumber = scanner.Line()
+		value = scanner.Text()
 
 		value = strings.Trim(value, " ")
 		infos := strings.Split(value, " ")
 
-		if len(infos) > 1 && infos[1] == "ENGINE=InnoDB" {
-			continue
-		}
+		if len(infos) > 1 && infos[0] == "CREATE" && infos[1] == "TABLE" {
+			nameIndex := 2
+			if hasPrefixWords(infos[2:], "IF", "NOT", "EXISTS") {
+				nameIndex = 5
+			}
+			if len(infos) <= nameIndex {
+				parseError("missing table name")
+				continue
+			}
 
-		if infos[0] == "CREATE" && infos[1] == "TABLE" {
 			if analyzingTable {
 				tables[table.Name] = table
 			}
 			analyzingTable = true
-			tableName := strings.Trim(infos[2], "`")
+			identifier, rest := infos[nameIndex], strings.Join(infos[nameIndex+1:], " ")
+			if open := strings.IndexByte(identifier, '('); open >= 0 {
+				identifier, rest = identifier[:open], identifier[open:]+" "+rest
+			}
+			tableName := mysqlTableName(identifier, schemaPrefix)
 			cols := make(map[string]Column)
 			indexes := make(map[string]Index)
 
 			constraints := make(map[string](map[string]Constraint))
 
 			table = Table{Name: tableName, Columns: cols, Indexes: indexes, Constraints: constraints}
+
+			// the definitions are expected on their own lines, as mysqldump
+			// writes them
+			if rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest), "(")); rest != "" {
+				parseError("definitions on the CREATE TABLE line")
+				if strings.HasSuffix(rest, ";") {
+					tables[table.Name] = table
+					analyzingTable = false
+				}
+			}
+			continue
+		}
+
+		if !analyzingTable {
+			for _, statement := range statements.line(scanner.Text()) {
+				addStatement(statement)
+			}
+		}
+
+		//end of the table definition
+		if analyzingTable && strings.HasPrefix(infos[0], ")") {
+			table.Options = parseTableOptions(value)
+			table.Charset = charsetOption(value)
+			tables[table.Name] = table
+			analyzingTable = false
 			continue
 		}
 
 		//column definition
 		if analyzingTable && !isKeyword(infos[0]) {
 
-			other := strings.Trim(strings.Join(infos[2:], " "), ",")
-			name := strings.Trim(infos[0], "`")
+			if isUnsupported(infos[0]) {
+				parseError("unsupported definition")
+				continue
+			}
 
-			column := Column{
-				Name:  name,
-				Type:  infos[1],
-				Other: other,
+			if len(infos) < 2 {
+				parseError("column definition without a type")
+				continue
 			}
 
-			table.Columns[name] = column
+			// the type ends at the first space outside its parentheses, so
+			// decimal(10, 2) stays whole
+			rest := strings.TrimSpace(value[len(infos[0]):])
+			columnType := leadingValue(rest)
+			other := strings.Trim(strings.TrimSpace(rest[len(columnType):]), ",")
+			name := strings.Trim(infos[0], "`")
+
+			table.Columns[name] = newColumn(name, columnType, other, len(table.Columns))
 
 			continue
 		}
 
 		//indexes definitions
-		if analyzingTable && infos[0] == "KEY" {
+		if analyzingTable && (infos[0] == "KEY" || infos[0] == "INDEX" || infos[0] == "FULLTEXT" || infos[0] == "SPATIAL") {
+
+			//FULLTEXT KEY `name` (`column`)
+			kind := IndexKind("")
+			if infos[0] == "FULLTEXT" || infos[0] == "SPATIAL" {
+				kind = IndexKind(infos[0])
+				infos = infos[1:]
+			}
+
+			if len(infos) < 3 {
+				parseError("index definition without columns")
+				continue
+			}
 
 			name := strings.Trim(infos[1], "`")
-			columnName := strings.Trim(infos[2], ",")
-			columnName = strings.Trim(columnName, "(")
-			columnName = strings.Trim(columnName, ")")
-			columnName = strings.Trim(columnName, "`")
+			if expression, isExpression := indexExpression(value); isExpression {
+				table.Indexes[expression] = Index{
+					Name:         name,
+					ColumnName:   expression,
+					Kind:         kind,
+					IsExpression: true,
+					Expression:   expression,
+				}
+				continue
+			}
+
+			columnName, prefixLength := indexKeyParts(value)
 
 			index := Index{
-				Name:       name,
-				ColumnName: columnName,
+				Name:         name,
+				ColumnName:   columnName,
+				Kind:         kind,
+				PrefixLength: prefixLength,
 			}
 
 			table.Indexes[columnName] = index
@@ -305,54 +1641,201 @@ func parseTables(data string) map[string]Table {
 		//constraints definitions
 		if analyzingTable && infos[0] == "CONSTRAINT" {
 
-			name := strings.Trim(infos[1], "`")
-
-			columnName := strings.Trim(infos[4], "(")
-			columnName = strings.Trim(columnName, ")")
-			columnName = strings.Trim(columnName, "`")
+			//CONSTRAINT `name` PRIMARY KEY (`id`) is the same key as PRIMARY KEY (`id`)
+			columnsIndex := keyColumnsIndex(infos, 3)
+			if len(infos) < 5 || columnsIndex < 0 {
+				parseError("incomplete constraint definition")
+				continue
+			}
 
 			constraintType := infos[2]
+			name := strings.Trim(infos[1], "`")
+			if constraintType == "PRIMARY" {
+				name = "PRIMARY"
+			}
 
-			other := strings.Join(infos[5:], " ")
-			other = strings.Trim(other, ",")
-
-			constraint := Constraint{
+			addConstraint(table, Constraint{
 				Name:       name,
-				ColumnName: columnName,
+				ColumnName: keyColumnName(infos[columnsIndex]),
 				Type:       constraintType,
-				Other:      other,
-			}
-			if table.Constraints[columnName] == nil {
-				table.Constraints[columnName] = make(map[string]Constraint)
-			}
-			table.Constraints[columnName][constraintType] = constraint
+				Other:      strings.Trim(strings.Join(infos[columnsIndex+1:], " "), ","),
+			})
 		}
 
 		if analyzingTable && (infos[0] == "PRIMARY" || infos[0] == "UNIQUE") {
 
-			columnName := strings.Trim(infos[2], ",")
-			columnName = strings.Trim(columnName, "(")
-			columnName = strings.Trim(columnName, ")")
-			columnName = strings.Trim(columnName, "`")
+			//UNIQUE KEY `name` (`column`)
+			columnsIndex := keyColumnsIndex(infos, 1)
+			if columnsIndex < 0 {
+				parseError("key definition without columns")
+				continue
+			}
 
+			columnName := keyColumnName(infos[columnsIndex])
 			constraintType := infos[0]
-			name := columnName
+			name := "PRIMARY"
+			if constraintType == "UNIQUE" {
+				name = columnName
+				if columnsIndex == 3 {
+					name = strings.Trim(infos[2], "`")
+				}
+			}
 
-			constraint := Constraint{
+			addConstraint(table, Constraint{
 				Name:       name,
 				ColumnName: columnName,
 				Type:       constraintType,
-				Other:      "",
+				Other:      strings.Trim(strings.Join(infos[columnsIndex+1:], " "), ","),
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Schema{}, nil, err
+	}
+	if analyzingTable {
+		tables[table.Name] = table
+	}
+	for _, statement := range statements.flush() {
+		addStatement(statement)
+	}
+
+	if strict && len(parseErrors) > 0 {
+		return Schema{}, nil, &parseErrors[0]
+	}
+	return schema, parseErrors, nil
+}
+
+// keyColumnsIndex returns the index of the first word, from start on, holding
+// the parenthesised column list of a key, or -1.
+func keyColumnsIndex(infos []string, start int) int {
+	for i := start; i < len(infos); i++ {
+		if strings.HasPrefix(infos[i], "(") {
+			return i
+		}
+	}
+	return -1
+}
+
+func keyColumnName(columns string) string {
+	columnName := strings.Trim(columns, ",")
+	columnName = strings.Trim(columnName, "(")
+	columnName = strings.Trim(columnName, ")")
+	return strings.Trim(columnName, "`")
+}
+
+// indexKeyParts returns the column names of an index definition in the
+// form the indexes are looked up by, without quotes at either end, and the
+// prefix length of its last column.
+func indexKeyParts(def string) (string, int) {
+	open := strings.IndexByte(def, '(')
+	if open < 0 {
+		return "", 0
+	}
+	end := matchingParen(def, open)
+	if end < 0 {
+		return "", 0
+	}
+
+	parts := splitTopLevel(def[open+1:end], ',')
+	prefixLength := 0
+	for i, part := range parts {
+		if lengthOpen := strings.IndexByte(part, '('); lengthOpen >= 0 {
+			if i == len(parts)-1 {
+				prefixLength, _ = strconv.Atoi(strings.TrimSpace(strings.Trim(part[lengthOpen:], "()")))
 			}
-			if table.Constraints[columnName] == nil {
-				table.Constraints[columnName] = make(map[string]Constraint)
+			parts[i] = strings.TrimSpace(part[:lengthOpen])
+		}
+	}
+	return strings.Trim(strings.Join(parts, ","), "`"), prefixLength
+}
+
+func indexKindLabel(kind IndexKind) string {
+	if kind == "" {
+		return "INDEX"
+	}
+	return string(kind)
+}
+
+func prefixLengthLabel(length int) string {
+	if length == 0 {
+		return ""
+	}
+	return strconv.Itoa(length)
+}
+
+// indexExpression returns the normalized key part list of a functional
+// index definition, KEY `idx` ((col + 1)), and whether it is one: the key
+// parts of a functional index are expressions in their own parentheses.
+func indexExpression(def string) (string, bool) {
+	open := strings.IndexByte(def, '(')
+	if open < 0 {
+		return "", false
+	}
+	end := matchingParen(def, open)
+	if end < 0 {
+		return "", false
+	}
+
+	parts := splitTopLevel(def[open+1:end], ',')
+	isExpression := false
+	for i, part := range parts {
+		parts[i] = normalizeExpression(part)
+		if strings.HasPrefix(parts[i], "(") {
+			isExpression = true
+		}
+	}
+	return strings.Join(parts, ","), isExpression
+}
+
+// expressionOperators are the characters of the operators that whitespace
+// around is insignificant for, e.g. `id` + 1 and `id`+1.
+const expressionOperators = "+-*/%=<>!&|^~"
+
+// normalizeExpression collapses whitespace and removes it next to
+// parentheses, commas and operators, outside string literals and quoted
+// identifiers, so expressions formatted differently compare equal.
+func normalizeExpression(expression string) string {
+
+	var b strings.Builder
+	var quote rune
+	var last rune
+	space := false
+	for _, c := range strings.TrimSpace(expression) {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
 			}
-			table.Constraints[columnName][constraintType] = constraint
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case unicode.IsSpace(c):
+			space = true
+			continue
+		}
+
+		if space && !strings.ContainsRune("(),"+expressionOperators, c) && !strings.ContainsRune("(,"+expressionOperators, last) {
+			b.WriteByte(' ')
 		}
+		space = false
+		b.WriteRune(c)
+		last = c
+	}
+	return b.String()
+}
+
+// splitMatchType removes the MATCH FULL|PARTIAL|SIMPLE clause from a foreign
+// key definition and returns it separately.
+func splitMatchType(other string) (string, string) {
+	i := findWord(other, "MATCH")
+	if i < 0 {
+		return other, ""
 	}
-	tables[table.Name] = table
 
-	return tables
+	rest := strings.TrimLeft(other[i+len("MATCH"):], " ")
+	matchType := leadingValue(rest)
+	rest = strings.TrimLeft(rest[len(matchType):], " ")
+
+	return strings.TrimSpace(other[:i] + rest), strings.ToUpper(matchType)
 }
 
 func printTables(tables map[string]Table) {
@@ -372,15 +1855,129 @@ func printTable(table Table) {
 	w.Flush()
 }
 
-func printDiffs(diffs []Diff, aFileName string, bFileName string) {
-	w := tabwriter.NewWriter(os.Stdout, 1, 1, 1, ' ', 0)
-	fmt.Printf("\n\nDiffs\n\n")
-	fmt.Fprintf(w, "Type\t|\tTarget\t|\t%s\t|\t%s\n", aFileName, bFileName)
-	for _, diff := range diffs {
+type textOptions struct {
+	Color   bool
+	GroupBy string
+}
 
-		fmt.Fprintf(w, "%v\t|\t%v\t|\t%v\t|\t%v\n", diff.Type, diff.Target, diff.A, diff.B)
+func printDiffs(out io.Writer, diffs []Diff, aFileName string, bFileName string, opts textOptions) {
+	w := tabwriter.NewWriter(out, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(out, "\n\nDiffs\n\n")
+
+	if opts.GroupBy == "table" {
+		byTable := groupByTable(diffs)
+		tableNames := make([]string, 0, len(byTable))
+		for tableName := range byTable {
+			tableNames = append(tableNames, tableName)
+		}
+		sort.Strings(tableNames)
+
+		for i, tableName := range tableNames {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			if opts.Color {
+				fmt.Fprintf(w, "Table: %s\n", sgr(styleBold, tableName))
+			} else {
+				fmt.Fprintf(w, "Table: %s\n", tableName)
+			}
+			printDiffRows(w, byTable[tableName], aFileName, bFileName, opts.Color)
+		}
+	} else {
+		printDiffRows(w, diffs, aFileName, bFileName, opts.Color)
 	}
 
 	w.Flush()
-	fmt.Println()
+	fmt.Fprintf(out, "\n%s\nSummary: %s\n", strings.Repeat("-", 40), summaryLine(summarise(diffs)))
+	fmt.Fprintln(out)
+}
+
+func printDiffRows(w io.Writer, diffs []Diff, aFileName string, bFileName string, color bool) {
+	typeHeader, severityHeader, targetHeader := "Type", "Severity", "Target"
+	if color {
+		typeHeader, severityHeader, targetHeader = sgr(colorDefault, typeHeader), sgr(colorDefault, severityHeader), sgr(styleNormal, targetHeader)
+	}
+	fmt.Fprintf(w, "%s\t|\t%s\t|\t%s\t|\t%s\t|\t%s\n", typeHeader, severityHeader, targetHeader, aFileName, bFileName)
+	for _, diff := range diffs {
+
+		diffType, severity, target := diff.Type, diff.Severity, diff.Target
+		if color {
+			diffType, severity, target = sgr(diffTypeColor(diffType), diffType), sgr(severityColor(severity), severity), colorTarget(target)
+		}
+		fmt.Fprintf(w, "%v\t|\t%v\t|\t%v\t|\t%v\t|\t%v\n", diffType, severity, target, diff.A, diff.B)
+	}
+}
+
+func summarise(diffs []Diff) map[string]int {
+	counts := make(map[string]int)
+	for _, d := range diffs {
+		counts[d.Type]++
+	}
+	return counts
+}
+
+func summaryLine(counts map[string]int) string {
+
+	var parts []string
+	for _, diffType := range diffTypeOrder {
+		count := counts[diffType]
+		if count == 0 {
+			continue
+		}
+
+		label := strings.ToLower(strings.ReplaceAll(diffType, "_", " "))
+		if count > 1 {
+			label = plural(label)
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", count, label))
+	}
+
+	if len(parts) == 0 {
+		return "no diffs"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func plural(word string) string {
+	// sensitive column changed, Sensitive Column Changed
+	if n := len(word) - len(" changed"); n > 0 && strings.EqualFold(word[n:], " changed") {
+		return plural(word[:n]) + word[n:]
+	}
+	if n := len(word); n > 1 && word[n-1] == 'y' && !strings.ContainsRune("aeiou", rune(word[n-2])) {
+		return word[:n-1] + "ies"
+	}
+	for _, suffix := range []string{"s", "x", "ch", "sh"} {
+		if strings.HasSuffix(word, suffix) {
+			return word + "es"
+		}
+	}
+	return word + "s"
+}
+
+// printParseWarnings tells how many definitions of the file were skipped,
+// since their tables are compared without them.
+func printParseWarnings(out io.Writer, parseErrors []ParseError, fileName string) {
+	if len(parseErrors) == 0 {
+		return
+	}
+
+	word := "definition"
+	if len(parseErrors) > 1 {
+		word = plural(word)
+	}
+	fmt.Fprintf(out, "\nWarning: %d %s in %s could not be parsed and were skipped, -strict reports the first one\n", len(parseErrors), word, fileName)
+}
+
+func printDiffStream(out io.Writer, diffs <-chan Diff, aFileName string, bFileName string) int {
+	fmt.Fprintf(out, "\n\nDiffs\n\n")
+	fmt.Fprintf(out, "Type | Severity | Target | %s | %s\n", aFileName, bFileName)
+	count := 0
+	for diff := range diffs {
+
+		fmt.Fprintf(out, "%v | %v | %v | %v | %v\n", diff.Type, diff.Severity, diff.Target, diff.A, diff.B)
+		count++
+	}
+
+	fmt.Fprintln(out)
+	return count
 }
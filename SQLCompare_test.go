@@ -0,0 +1,365 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"SQLCompare/testutil"
+)
+
+func mustParseTables(t testing.TB, schema string) map[string]Table {
+	t.Helper()
+	tables, _, err := parseTables(strings.NewReader(schema), false, "")
+	if err != nil {
+		t.Fatalf("parseTables: %v", err)
+	}
+	return tables
+}
+
+func TestCompareTablesDeterministic(t *testing.T) {
+
+	tablesA, _, err := parseFile("examples/magento/before.sql", runOptions{Dialect: "mysql"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tablesB, _, err := parseFile("examples/magento/after.sql", runOptions{Dialect: "mysql"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := compareTables(tablesA, tablesB, CompareOptions{})
+	if len(want) == 0 {
+		t.Fatal("expected diffs between the magento examples")
+	}
+	for i := 0; i < 1000; i++ {
+		got := compareTables(tablesA, tablesB, CompareOptions{})
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("run %d: diffs differ from the first run", i)
+		}
+	}
+}
+
+// TestExamples compares every example pair with the flags given in
+// examples/README.md and checks the result against its expected_diffs.json.
+func TestExamples(t *testing.T) {
+
+	tests := []struct {
+		dir  string
+		opts runOptions
+	}{
+		{"alembic", runOptions{Dialect: "mysql", Alembic: true}},
+		{"cockroachdb", runOptions{Dialect: "cockroachdb"}},
+		{"laravel", runOptions{Dialect: "mysql"}},
+		{"magento", runOptions{Dialect: "mysql"}},
+		{"postgres", runOptions{Dialect: "postgres"}},
+		{"spatial", runOptions{Dialect: "mysql"}},
+		{"sqlite", runOptions{Dialect: "sqlite"}},
+		{"sqlserver", runOptions{Dialect: "sqlserver"}},
+		{"wordpress", runOptions{Dialect: "mysql"}},
+	}
+
+	dirs, err := filepath.Glob("examples/*/before.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != len(tests) {
+		t.Errorf("got %d examples, want %d", len(dirs), len(tests))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			dir := filepath.Join("examples", tt.dir)
+			want, err := ioutil.ReadFile(filepath.Join(dir, "expected_diffs.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pathA := filepath.Join(dir, "before.sql")
+			pathB := filepath.Join(dir, "after.sql")
+			opts := tt.opts
+			opts.Format = "json"
+			var out bytes.Buffer
+			if _, err := compareFiles(&out, opts, pathA, pathB); err != nil {
+				t.Fatal(err)
+			}
+			// the expected diffs are generated from inside the example directory
+			got := strings.NewReplacer(`"`+pathA+`"`, `"before.sql"`, `"`+pathB+`"`, `"after.sql"`).Replace(out.String())
+			if got != string(want) {
+				t.Errorf("diffs differ from %s/expected_diffs.json, see examples/README.md to regenerate it:\n%s", dir, got)
+			}
+		})
+	}
+}
+
+func TestParseMySQLSchemaObjects(t *testing.T) {
+
+	dump := strings.Join([]string{
+		"CREATE TABLE `t` (",
+		"  `id` int NOT NULL,",
+		"  `a` int DEFAULT NULL,",
+		"  PRIMARY KEY (`id`)",
+		") ENGINE=InnoDB;",
+		"INSERT INTO `t` VALUES (1,'a;b'),(2,'x');",
+		"DELIMITER ;;",
+		"/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW BEGIN",
+		"  SET NEW.a = 1;",
+		"END */;;",
+		"DELIMITER ;",
+		"DELIMITER $$",
+		"CREATE PROCEDURE p()",
+		"BEGIN",
+		"  SELECT 1;",
+		"END$$",
+		"DELIMITER ;",
+		"/*!50001 CREATE ALGORITHM=UNDEFINED */",
+		"/*!50001 VIEW `v` AS select `t`.`id` AS `id` from `t` */;",
+	}, "\n")
+
+	schema, parseErrors, err := parseMySQLSchema(strings.NewReader(dump), false, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parseErrors) > 0 {
+		t.Errorf("unexpected parse errors: %v", parseErrors)
+	}
+	if _, exists := schema.Tables["t"]; !exists {
+		t.Errorf("table t not parsed")
+	}
+	if got, want := schema.Views["v"].Definition, "select `t`.`id` AS `id` from `t`"; got != want {
+		t.Errorf("view v: got %q, want %q", got, want)
+	}
+	if got, want := schema.Triggers["trg"].Body, "BEGIN SET NEW.a=1; END"; got != want {
+		t.Errorf("trigger trg: got %q, want %q", got, want)
+	}
+	if got, want := schema.Routines["PROCEDURE p"].Body, "BEGIN SELECT 1; END"; got != want {
+		t.Errorf("procedure p: got %q, want %q", got, want)
+	}
+}
+
+// BenchmarkParseTables parses a 100 MB dump straight from the file, as
+// parseFile does.
+func BenchmarkParseTables(b *testing.B) {
+
+	const dumpSize = 100 << 20
+
+	path := filepath.Join(b.TempDir(), "dump.sql")
+	f, err := os.Create(path)
+	if err != nil {
+		b.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	rng := rand.New(rand.NewSource(1))
+	var size int64
+	for chunk := 0; size < dumpSize; chunk++ {
+		// every chunk holds table_0 to table_999, so their names get the
+		// number of the chunk
+		schema := testutil.GenerateSchema(1000, 20, rng)
+		schema = strings.ReplaceAll(schema, "`table_", fmt.Sprintf("`chunk_%d_table_", chunk))
+		n, err := w.WriteString(schema)
+		if err != nil {
+			b.Fatal(err)
+		}
+		size += int64(n)
+	}
+	if err := w.Flush(); err != nil {
+		b.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f, err := os.Open(path)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, _, err := parseTables(f, false, ""); err != nil {
+			b.Fatal(err)
+		}
+		f.Close()
+	}
+}
+
+func TestMissingRequiredColumns(t *testing.T) {
+
+	table := mustParseTables(t, "CREATE TABLE `users` (\n"+
+		"  `id` int NOT NULL,\n"+
+		"  `created_at` datetime NOT NULL,\n"+
+		"  `updated_at` datetime NOT NULL,\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		") ENGINE=InnoDB;\n")["users"]
+
+	tests := []struct {
+		name     string
+		required []string
+		want     []string
+	}{
+		{"none required", nil, nil},
+		{"all present", []string{"id", "created_at", "updated_at"}, nil},
+		{"some missing", []string{"created_at", "deleted_at", "updated_at", "created_by"}, []string{"deleted_at", "created_by"}},
+		{"all missing", []string{"deleted_at"}, []string{"deleted_at"}},
+		{"names are case sensitive", []string{"ID"}, []string{"ID"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.MissingRequiredColumns(tt.required); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MissingRequiredColumns(%q) = %q, want %q", tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseGeneratedSchemas checks that every generated schema parses
+// without errors and compares equal to itself.
+func TestParseGeneratedSchemas(t *testing.T) {
+
+	for seed := int64(0); seed < 20; seed++ {
+		schema := testutil.GenerateSchema(30, 12, rand.New(rand.NewSource(seed)))
+
+		tables, _, err := parseTables(strings.NewReader(schema), true, "")
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		if len(tables) != 30 {
+			t.Errorf("seed %d: parsed %d tables, want 30", seed, len(tables))
+		}
+		if diffs := compareTables(tables, mustParseTables(t, schema), CompareOptions{}); len(diffs) > 0 {
+			t.Errorf("seed %d: schema differs from itself: %+v", seed, diffs)
+		}
+	}
+}
+
+func TestNormalizeExpression(t *testing.T) {
+
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"( `id` + 1 )", "(`id`+1)"},
+		{"(`id`+1)", "(`id`+1)"},
+		{"concat( `a` ,  ' x + y ' )", "concat(`a`,' x + y ')"},
+		{"`a`  >=  10 AND `b` <> 'c'", "`a`>=10 AND `b`<>'c'"},
+		{"price * -1", "price*-1"},
+		{"`first name` = \"a  b\"", "`first name`=\"a  b\""},
+		{"select   `id`  from   `t`", "select `id` from `t`"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeExpression(tt.expression); got != tt.want {
+			t.Errorf("normalizeExpression(%q) = %q, want %q", tt.expression, got, tt.want)
+		}
+	}
+}
+
+func TestFunctionalIndexWhitespace(t *testing.T) {
+
+	tablesA := mustParseTables(t, "CREATE TABLE `t` (\n"+
+		"  `id` int NOT NULL,\n"+
+		"  KEY `fx` ((`id` + 1))\n"+
+		") ENGINE=InnoDB;\n")
+	tablesB := mustParseTables(t, "CREATE TABLE `t` (\n"+
+		"  `id` int NOT NULL,\n"+
+		"  KEY `fx` (( `id`+1 ))\n"+
+		") ENGINE=InnoDB;\n")
+
+	if diffs := compareTables(tablesA, tablesB, CompareOptions{}); len(diffs) > 0 {
+		t.Errorf("unexpected diffs: %+v", diffs)
+	}
+}
+
+func TestAutoIncrementCounterIgnored(t *testing.T) {
+
+	schema := "CREATE TABLE `t` (\n" +
+		"  `id` int NOT NULL AUTO_INCREMENT,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=%d DEFAULT CHARSET=utf8mb4;\n"
+
+	tablesA := mustParseTables(t, fmt.Sprintf(schema, 12))
+	tablesB := mustParseTables(t, fmt.Sprintf(schema, 98765))
+	if diffs := compareTables(tablesA, tablesB, CompareOptions{}); len(diffs) > 0 {
+		t.Errorf("unexpected diffs: %+v", diffs)
+	}
+}
+
+func TestAdditiveOnlyFailOnBreaking(t *testing.T) {
+
+	schemaA := "CREATE TABLE `t` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+
+	tests := []struct {
+		column string
+		differ bool
+	}{
+		{"  `x` int NOT NULL,\n", true},
+		{"  `x` int NOT NULL DEFAULT '0',\n", false},
+		{"  `x` int DEFAULT NULL,\n", false},
+	}
+
+	for _, test := range tests {
+		dir := t.TempDir()
+		pathA := filepath.Join(dir, "a.sql")
+		pathB := filepath.Join(dir, "b.sql")
+		schemaB := strings.Replace(schemaA, "  PRIMARY KEY", test.column+"  PRIMARY KEY", 1)
+		for path, schema := range map[string]string{pathA: schemaA, pathB: schemaB} {
+			if err := ioutil.WriteFile(path, []byte(schema), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		opts := runOptions{Quiet: true, Dialect: "mysql", Format: "text", AdditiveOnly: true, FailOnBreaking: true}
+		differ, err := run(opts, pathA, pathB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if differ != test.differ {
+			t.Errorf("%q: run returned %v, want %v", test.column, differ, test.differ)
+		}
+	}
+}
+
+func TestCreateTableOnOneLine(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		dump   string
+		errors int
+	}{
+		{"one line", "CREATE TABLE t (id int NOT NULL, PRIMARY KEY (id));\n", 1},
+		{"no space before the parenthesis", "CREATE TABLE `t`(id int NOT NULL);\n", 1},
+		{"if not exists", "CREATE TABLE IF NOT EXISTS `t` (`id` int NOT NULL);\n", 1},
+		{"definitions on their own lines", "CREATE TABLE `t` (\n  `id` int NOT NULL\n) ENGINE=InnoDB;\n", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dump := tt.dump + "CREATE TABLE `u` (\n  `id` int NOT NULL\n) ENGINE=InnoDB;\n"
+			tables, parseErrors, err := ParseTablesWithErrors(strings.NewReader(dump))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(parseErrors) != tt.errors {
+				t.Errorf("got parse errors %v, want %d", parseErrors, tt.errors)
+			}
+			if _, exists := tables["t"]; !exists {
+				t.Error("table t not parsed")
+			}
+			if columns := tables["u"].Columns; len(columns) != 1 {
+				t.Errorf("got columns %v in the next table, want id", columns)
+			}
+		})
+	}
+}
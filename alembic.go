@@ -0,0 +1,64 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var typeArgsSpace = regexp.MustCompile(`\((\d+), (\d+)\)`)
+
+// stripAlembic removes what `alembic upgrade --sql` adds around the DDL, as
+// alembicFilter does line by line.
+func stripAlembic(data string) string {
+
+	var b strings.Builder
+	var filter alembicFilter
+	for _, line := range strings.Split(data, "\n") {
+		if line, keep := filter.line(line); keep {
+			b.WriteString(line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// alembicFilter removes what `alembic upgrade --sql` adds around the DDL: the
+// "-- Running upgrade" separators, transaction statements and the
+// alembic_version bookkeeping table. SQLAlchemy indents columns with tabs and
+// writes NUMERIC(10, 2), both are normalised for parseTables.
+type alembicFilter struct {
+	// inside a statement on the alembic_version table
+	skipping bool
+}
+
+// line returns the line rewritten for parseTables, and false when it is
+// dropped.
+func (f *alembicFilter) line(line string) (string, bool) {
+
+	line = strings.TrimRight(line, " \t\r")
+	trimmed := strings.TrimLeft(line, " \t")
+	upper := strings.ToUpper(trimmed)
+
+	if f.skipping {
+		f.skipping = !strings.HasSuffix(trimmed, ";")
+		return "", false
+	}
+
+	switch {
+	case strings.HasPrefix(trimmed, "-- Running upgrade"), strings.HasPrefix(trimmed, "-- Running downgrade"):
+		return "", false
+	case upper == "BEGIN;", upper == "COMMIT;", upper == "START TRANSACTION;":
+		return "", false
+	case strings.Contains(upper, "ALEMBIC_VERSION") && (strings.HasPrefix(upper, "CREATE TABLE") ||
+		strings.HasPrefix(upper, "INSERT INTO") ||
+		strings.HasPrefix(upper, "UPDATE") ||
+		strings.HasPrefix(upper, "DELETE FROM") ||
+		strings.HasPrefix(upper, "DROP TABLE")):
+		f.skipping = !strings.HasSuffix(trimmed, ";")
+		return "", false
+	}
+
+	if trimmed != line {
+		line = "  " + trimmed
+	}
+	return typeArgsSpace.ReplaceAllString(line, "($1,$2)"), true
+}
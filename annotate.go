@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// annotateSchema returns the schema file the tables were parsed from with a
+// "-- DIFF:" comment line right before the definition of each table, column,
+// index or constraint a diff points at.
+func annotateSchema(data string, diffs []Diff, tables map[string]Table, schemaPrefix string) string {
+
+	annotations := make(map[string][]string)
+	for _, d := range diffs {
+		key := annotationKey(d, tables)
+		annotations[key] = append(annotations[key], fmt.Sprintf("-- DIFF: %s %s vs %s", d.Type, annotationValue(d.A), annotationValue(d.B)))
+	}
+
+	var b strings.Builder
+	tableName := ""
+	lines := strings.Split(data, "\n")
+	for i, line := range lines {
+		words := strings.Fields(line)
+
+		key := ""
+		switch {
+		case hasPrefixWords(words, "CREATE", "TABLE") && len(words) > 2:
+			nameIndex := 2
+			if hasPrefixWords(words[2:], "IF", "NOT", "EXISTS") && len(words) > 5 {
+				nameIndex = 5
+			}
+			tableName = mysqlTableName(strings.TrimSuffix(words[nameIndex], "("), schemaPrefix)
+			key = tableName
+		case tableName == "" || len(words) == 0:
+		case strings.HasPrefix(words[0], ")"):
+			tableName = ""
+		case hasPrefixWords(words, "PRIMARY", "KEY"):
+			key = tableName + " key:PRIMARY"
+		case hasPrefixWords(words, "UNIQUE", "KEY") && len(words) > 2,
+			(hasPrefixWords(words, "FULLTEXT") || hasPrefixWords(words, "SPATIAL")) && len(words) > 2:
+			key = tableName + " key:" + strings.Trim(words[2], "`")
+		case (hasPrefixWords(words, "KEY") || hasPrefixWords(words, "CONSTRAINT")) && len(words) > 1:
+			key = tableName + " key:" + strings.Trim(words[1], "`")
+		default:
+			key = tableName + " column:" + strings.Trim(words[0], "`")
+		}
+
+		if key != "" {
+			for _, annotation := range annotations[key] {
+				b.WriteString(annotation)
+				if strings.HasSuffix(line, "\r") {
+					b.WriteString("\r")
+				}
+				b.WriteString("\n")
+			}
+		}
+
+		b.WriteString(line)
+		if i < len(lines)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// annotationKey returns the key annotateSchema looks up for the line the
+// diff points at: the table name, followed by the column or the index and
+// constraint name.
+func annotationKey(d Diff, tables map[string]Table) string {
+
+	switch d.Type {
+	case MissingTable, RenamedTable:
+		return d.A
+	case WrongInterleave, WrongTableEncryption, WrongTableStatsOption:
+		return d.Target
+	case MissingColumn, RenamedColumn:
+		return d.Target + " column:" + d.A
+	case MissingIndex, WrongIndexName:
+		tableName, _ := splitTarget(d.Target)
+		return tableName + " key:" + d.A
+	case WrongIndexKind, WrongIndexPrefixLength:
+		tableName, columnName := splitTarget(d.Target)
+		return tableName + " key:" + tables[tableName].Indexes[columnName].Name
+	case MissingConstraint:
+		tableName, columnName := splitTarget(d.Target)
+		return tableName + " key:" + tables[tableName].Constraints[columnName][d.A].Name
+	case WrongConstraintOther, WrongFKMatchType:
+		column := d.Target[:strings.LastIndex(d.Target, ".")]
+		constraintType := d.Target[strings.LastIndex(d.Target, ".")+1:]
+		tableName, columnName := splitTarget(column)
+		return tableName + " key:" + tables[tableName].Constraints[columnName][constraintType].Name
+	}
+
+	tableName, columnName := splitTarget(d.Target)
+	return tableName + " column:" + columnName
+}
+
+func annotationValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return strings.ReplaceAll(value, "\n", " ")
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderASCII renders both schemas as an ASCII entity-relationship diagram:
+// one box per table, referenced tables first, with a "---->" line from every
+// foreign key column to the column it references. Tables and columns only
+// in A are marked with "-", those only in B with "+" and changed columns
+// with "~".
+func renderASCII(tablesA map[string]Table, tablesB map[string]Table, opts CompareOptions) string {
+
+	tablesA, tablesB = filterTables(tablesA, opts), filterTables(tablesB, opts)
+
+	// the diagram follows B, with the tables dropped from A added
+	all := make(map[string]Table, len(tablesB))
+	for name, table := range tablesA {
+		all[name] = table
+	}
+	for name, table := range tablesB {
+		all[name] = table
+	}
+
+	var b strings.Builder
+	for i, name := range sortTablesByDependency(all) {
+		tableA, inA := tablesA[name]
+		tableB, inB := tablesB[name]
+
+		header := "  " + name
+		switch {
+		case !inA:
+			header = "+ " + name
+		case !inB:
+			header = "- " + name
+		}
+
+		type row struct {
+			text  string
+			arrow string
+		}
+		var rows []row
+		arrows := foreignKeyArrows(all[name])
+
+		columns := all[name].SortedColumns()
+		if inA && inB {
+			for _, column := range tableA.SortedColumns() {
+				if _, exists := tableB.Columns[column.Name]; !exists {
+					columns = append(columns, column)
+				}
+			}
+		}
+		for _, column := range columns {
+			marker := " "
+			if inA && inB {
+				columnA, existsA := tableA.Columns[column.Name]
+				columnB, existsB := tableB.Columns[column.Name]
+				switch {
+				case !existsA:
+					marker = "+"
+				case !existsB:
+					marker = "-"
+				case !opts.sameType(columnA.Type, columnB.Type) || columnA.Other != columnB.Other:
+					marker = "~"
+				}
+			}
+			rows = append(rows, row{text: fmt.Sprintf("%s %s %s", marker, column.Name, column.Type), arrow: arrows[column.Name]})
+		}
+
+		width := len(header)
+		for _, r := range rows {
+			if len(r.text) > width {
+				width = len(r.text)
+			}
+		}
+		border := "+" + strings.Repeat("-", width+2) + "+\n"
+
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(border)
+		fmt.Fprintf(&b, "| %-*s |\n", width, header)
+		b.WriteString(border)
+		for _, r := range rows {
+			fmt.Fprintf(&b, "| %-*s |", width, r.text)
+			if r.arrow != "" {
+				b.WriteString("----> " + r.arrow)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(border)
+	}
+	return b.String()
+}
+
+// foreignKeyArrows returns the referenced table and columns of the foreign
+// keys of the table, by their first column.
+func foreignKeyArrows(table Table) map[string]string {
+	arrows := make(map[string]string)
+	for _, constraint := range table.SortedConstraints() {
+		if constraint.Type != "FOREIGN" {
+			continue
+		}
+		i := findWord(constraint.Other, "REFERENCES")
+		if i < 0 {
+			continue
+		}
+		referencedColumns, _ := parenList(constraint.Other[i:])
+		columnName := strings.TrimSpace(strings.Split(constraint.ColumnName, ",")[0])
+		arrows[strings.Trim(columnName, "`")] = fmt.Sprintf("%s.%s", referencedTable(constraint), strings.Join(referencedColumns, ","))
+	}
+	return arrows
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// batchPair is a row of a -batch file: two schema files and the label of
+// their section in the report.
+type batchPair struct {
+	PathA string
+	PathB string
+	Label string
+}
+
+// readBatchFile reads the file_a,file_b,label rows of a -batch CSV file. A
+// header row is skipped, a missing label defaults to the two paths and a
+// relative path is read from the directory of the CSV file.
+func readBatchFile(path string) ([]batchPair, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && len(records[0]) > 1 && strings.EqualFold(records[0][0], "file_a") && strings.EqualFold(records[0][1], "file_b") {
+		records = records[1:]
+	}
+
+	dir := filepath.Dir(path)
+	pairs := make([]batchPair, 0, len(records))
+	for i, record := range records {
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("row %d: expected file_a,file_b,label, got %d fields", i+1, len(record))
+		}
+		pair := batchPair{PathA: batchPath(dir, record[0]), PathB: batchPath(dir, record[1])}
+		if len(record) == 3 {
+			pair.Label = strings.TrimSpace(record[2])
+		}
+		if pair.Label == "" {
+			pair.Label = fmt.Sprintf("%s vs %s", record[0], record[1])
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, nil
+}
+
+func batchPath(dir string, path string) string {
+	path = strings.TrimSpace(path)
+	if path == stdinPath || isURL(path) || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// batchHeader renders the section header of a pair in the output format.
+func batchHeader(label string, format string) string {
+	switch format {
+	case "markdown":
+		return fmt.Sprintf("## %s\n\n", label)
+	}
+	return fmt.Sprintf("=== %s ===\n", label)
+}
+
+// runBatch compares every pair of the -batch file, writing one report with
+// a section per pair, and reports whether any pair differs. A pair that
+// cannot be read is reported in its section and fails the batch once all
+// pairs are compared.
+func runBatch(opts runOptions, path string) (bool, error) {
+
+	pairs, err := readBatchFile(path)
+	if err != nil {
+		return false, fmt.Errorf("error reading batch file: %s, %v", path, err)
+	}
+
+	switch opts.Format {
+	case "text", "side-by-side", "markdown", "html":
+	default:
+		return false, fmt.Errorf("-batch only supports the text, side-by-side, markdown and html formats")
+	}
+
+	var w io.Writer = os.Stdout
+	if opts.Quiet {
+		w = ioutil.Discard
+	}
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return false, fmt.Errorf("error creating output file: %s, %v", opts.Output, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	// the pairs of an html batch are sections of a single document
+	var report *batchHTML
+	if opts.Format == "html" {
+		report = &batchHTML{}
+		opts.Pipeline.Format = report
+	}
+
+	differ, failed := false, 0
+	for i, pair := range pairs {
+		if report != nil {
+			report.label = pair.Label
+		} else {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprint(w, batchHeader(pair.Label, opts.Format))
+		}
+		pairDiffers, err := compareFiles(w, opts, pair.PathA, pair.PathB)
+		if err != nil {
+			if report != nil {
+				report.pairs = append(report.pairs, htmlPair{Heading: pair.Label, Error: err.Error()})
+			} else {
+				fmt.Fprintln(w, err)
+			}
+			failed++
+			continue
+		}
+		differ = differ || pairDiffers
+	}
+	if report != nil {
+		fmt.Fprint(w, renderHTMLReport(htmlReport{Title: path, Pairs: report.pairs}))
+	}
+	if failed > 0 {
+		return differ, fmt.Errorf("%d of %d batch pairs could not be compared", failed, len(pairs))
+	}
+	return differ, nil
+}
+
+// batchHTML is the format stage of an html batch, keeping the report of
+// every pair under its label until all of them are rendered together.
+type batchHTML struct {
+	label string
+	pairs []htmlPair
+}
+
+func (b *batchHTML) Format(w io.Writer, r Report) error {
+	b.pairs = append(b.pairs, newHTMLPair(b.label, r.Diffs, r.PathA, r.PathB))
+	return nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBatchHTMLSingleDocument(t *testing.T) {
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.sql": splitSchemaA,
+		"b.sql": splitSchemaB,
+		"pairs.csv": "file_a,file_b,label\n" +
+			"a.sql,b.sql,billing\n" +
+			"a.sql,a.sql,users\n" +
+			"a.sql,missing.sql,orders\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := filepath.Join(dir, "report.html")
+	differ, err := runBatch(runOptions{Dialect: "mysql", Format: "html", Output: out}, filepath.Join(dir, "pairs.csv"))
+	if err == nil {
+		t.Error("expected an error for the missing file")
+	}
+	if !differ {
+		t.Error("runBatch reported no differences")
+	}
+
+	data, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := string(data)
+	for _, tag := range []string{"<!DOCTYPE html>", "<html", "</html>", "<body>"} {
+		if n := strings.Count(report, tag); n != 1 {
+			t.Errorf("%s appears %d times, want once", tag, n)
+		}
+	}
+	for _, heading := range []string{"<h1>billing</h1>", "<h1>users</h1>", "<h1>orders</h1>"} {
+		if !strings.Contains(report, heading) {
+			t.Errorf("report has no %s", heading)
+		}
+	}
+	if !strings.Contains(report, `<p class="error">`) {
+		t.Error("report has no error for the missing file")
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FAMILY f2 or CREATE [IF NOT EXISTS] FAMILY f2 inside a column definition
+var columnFamilyClause = regexp.MustCompile(`(?i)\s+(CREATE\s+(IF\s+NOT\s+EXISTS\s+)?)?FAMILY\s+("[^"]+"|\w+)`)
+
+// ParseCockroachDBDump parses the output of cockroach dump and SHOW CREATE.
+// It is the PostgreSQL parser plus the CockroachDB extensions: INDEX and
+// FAMILY items inside CREATE TABLE, FAMILY clauses on columns and
+// INTERLEAVE IN PARENT. Statements reading AS OF SYSTEM TIME are skipped.
+func ParseCockroachDBDump(data string) (map[string]Table, error) {
+
+	tables := make(map[string]Table)
+
+	for _, statement := range splitStatements(data) {
+		words := strings.Fields(statement)
+		if strings.Contains(strings.ToUpper(statement), "AS OF SYSTEM TIME") {
+			continue
+		}
+
+		switch {
+		case hasPrefixWords(words, "CREATE", "TABLE"):
+			table, err := parseCockroachCreateTable(statement)
+			if err != nil {
+				return nil, err
+			}
+			if table.Name != "" {
+				tables[table.Name] = table
+			}
+		case hasPrefixWords(words, "ALTER", "TABLE"):
+			parsePostgresAlterTable(words, statement, tables)
+		case hasPrefixWords(words, "CREATE", "INDEX"),
+			hasPrefixWords(words, "CREATE", "UNIQUE", "INDEX"):
+			parsePostgresCreateIndex(words, statement, tables)
+		}
+	}
+
+	return tables, nil
+}
+
+func parseCockroachCreateTable(statement string) (Table, error) {
+
+	open := strings.IndexByte(statement, '(')
+	if open < 0 {
+		return Table{}, nil
+	}
+	end := matchingParen(statement, open)
+	if end < 0 {
+		return Table{}, fmt.Errorf("unbalanced parentheses in: %s", firstLine(statement))
+	}
+
+	// the items PostgreSQL understands go through its parser, the rest is
+	// attached to the table afterwards
+	var items []string
+	families := make(map[string]string)
+	var indexes []string
+	for _, item := range splitTopLevel(statement[open+1:end], ',') {
+		words := strings.Fields(item)
+
+		switch {
+		case hasPrefixWords(words, "FAMILY") && len(words) > 1:
+			name := unquoteIdentifier(words[1])
+			columns, _ := parenList(item)
+			for _, column := range columns {
+				families[column] = name
+			}
+		case hasPrefixWords(words, "INDEX"),
+			hasPrefixWords(words, "UNIQUE", "INDEX"),
+			hasPrefixWords(words, "INVERTED", "INDEX"):
+			indexes = append(indexes, item)
+		default:
+			if match := columnFamilyClause.FindStringSubmatch(item); match != nil && len(words) > 1 {
+				families[unquoteIdentifier(words[0])] = unquoteIdentifier(match[3])
+				item = columnFamilyClause.ReplaceAllString(item, "")
+			}
+			items = append(items, item)
+		}
+	}
+
+	table, err := parsePostgresCreateTable(statement[:open+1] + strings.Join(items, ",\n") + ")")
+	if err != nil || table.Name == "" {
+		return table, err
+	}
+
+	for _, item := range indexes {
+		words := strings.Fields(item)
+		nameIndex := 1
+		if strings.ToUpper(words[0]) != "INDEX" {
+			nameIndex = 2
+		}
+		if nameIndex >= len(words) {
+			continue
+		}
+
+		name := unquoteIdentifier(strings.SplitN(words[nameIndex], "(", 2)[0])
+		columns, _ := parenList(item)
+		columnName := joinColumnNames(columns)
+
+		if strings.ToUpper(words[0]) == "UNIQUE" {
+			addConstraint(table, Constraint{Name: name, ColumnName: columnName, Type: "UNIQUE"})
+			continue
+		}
+		table.Indexes[columnName] = Index{Name: name, ColumnName: columnName}
+	}
+
+	if len(families) > 0 {
+		table.Families = families
+	}
+
+	tail := strings.Fields(statement[end+1:])
+	if hasPrefixWords(tail, "INTERLEAVE", "IN", "PARENT") && len(tail) > 3 {
+		rest := strings.Join(tail[3:], " ")
+		parent := unquoteIdentifier(strings.TrimSpace(strings.SplitN(rest, "(", 2)[0]))
+		columns, _ := parenList(rest)
+		table.Interleave = fmt.Sprintf("%s (%s)", parent, strings.Join(columns, ","))
+	}
+
+	return table, nil
+}
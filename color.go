@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"strings"
+)
+
+// SGR codes are all two digits long so every coloured cell grows by the same
+// number of bytes and tabwriter keeps the columns aligned.
+const (
+	colorRed     = "31"
+	colorGreen   = "32"
+	colorYellow  = "33"
+	colorDefault = "39"
+	styleBold    = "01"
+	styleNormal  = "22"
+)
+
+func sgr(code string, s string) string {
+	return "\033[" + code + "m" + s + "\033[0m"
+}
+
+// isTerminal reports whether f is a character device, i.e. an interactive
+// terminal rather than a pipe or a regular file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func diffTypeColor(diffType string) string {
+	switch {
+	case strings.HasPrefix(diffType, "MISSING_"):
+		return colorRed
+	case strings.HasPrefix(diffType, "WRONG_"):
+		return colorYellow
+	}
+	return colorDefault
+}
+
+func severityColor(severity string) string {
+	switch severity {
+	case SeverityBreaking:
+		return colorRed
+	case SeverityWarning:
+		return colorYellow
+	}
+	return colorDefault
+}
+
+// colorTarget prints the table part of a target in bold.
+func colorTarget(target string) string {
+	tableName, rest := splitTarget(target)
+	if rest == "" {
+		return sgr(styleBold, tableName)
+	}
+	return sgr(styleBold, tableName) + "." + rest
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// the MySQL 8.0.23 visibility attribute, as written or as mysqldump wraps it
+	visibilityClause = regexp.MustCompile(`(?i)\s*(/\*!\d+\s+)?\b(IN)?VISIBLE\b(\s*\*/)?`)
+	// the NDB Cluster storage format of a column, wrapped by SHOW CREATE TABLE
+	// in /*!50606 ... */
+	columnFormatClause = regexp.MustCompile(`(?i)\s*(/\*!\d+\s+)?\bCOLUMN_FORMAT\s+(FIXED|DYNAMIC|DEFAULT)\b(\s*\*/)?`)
+)
+
+func newColumn(name string, columnType string, other string, ordinal int) Column {
+
+	invisible := false
+	if match := findClause(visibilityClause, other); match != nil {
+		invisible = match[4] >= 0
+		other = strings.TrimSpace(other[:match[0]] + other[match[1]:])
+	}
+
+	columnFormat := ""
+	if match := findClause(columnFormatClause, other); match != nil {
+		columnFormat = strings.ToUpper(other[match[4]:match[5]])
+		other = strings.TrimSpace(other[:match[0]] + other[match[1]:])
+	}
+
+	return Column{
+		Name:     name,
+		Type:     columnType,
+		Other:    other,
+		Ordinal:  ordinal,
+		Nullable: findWord(other, "NOT NULL") < 0 && findWord(other, "PRIMARY KEY") < 0,
+		Default:  columnDefault(other),
+
+		SetValues:    setValues(columnType),
+		Invisible:    invisible,
+		ColumnFormat: columnFormat,
+	}
+}
+
+// setValues returns the values of a SET('a','b') column type, or nil when
+// the column is not a SET.
+func setValues(columnType string) []string {
+	if !strings.HasPrefix(strings.ToUpper(columnType), "SET(") {
+		return nil
+	}
+	end := matchingParen(columnType, len("SET"))
+	if end < 0 {
+		return nil
+	}
+	return splitTopLevel(columnType[len("SET("):end], ',')
+}
+
+// subtractValues returns the values of a missing from b, in a's order.
+func subtractValues(a []string, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, value := range b {
+		inB[value] = true
+	}
+	var missing []string
+	for _, value := range a {
+		if !inB[value] {
+			missing = append(missing, value)
+		}
+	}
+	return missing
+}
+
+func nullability(column Column) string {
+	if column.Nullable {
+		return "NULL"
+	}
+	return "NOT NULL"
+}
+
+func visibility(column Column) string {
+	if column.Invisible {
+		return "INVISIBLE"
+	}
+	return "VISIBLE"
+}
+
+// columnFormat returns the NDB Cluster COLUMN_FORMAT of the column, DEFAULT
+// when it has none.
+func columnFormat(column Column) string {
+	if column.ColumnFormat == "" {
+		return "DEFAULT"
+	}
+	return column.ColumnFormat
+}
+
+func defaultClause(column Column) string {
+	if column.Default == "" {
+		return ""
+	}
+	return "DEFAULT " + column.Default
+}
+
+// findClause returns the submatch indexes of the first match of clause in
+// s that is not inside quotes, e.g. in a COMMENT, or nil.
+func findClause(clause *regexp.Regexp, s string) []int {
+	for _, match := range clause.FindAllStringSubmatchIndex(s, -1) {
+		if !quoted(s, match[0], match[1]) {
+			return match
+		}
+	}
+	return nil
+}
+
+// quoted reports whether s[start:end] overlaps a quoted string of s.
+func quoted(s string, start int, end int) bool {
+	for i := 0; i < len(s) && i < end; i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			closing := closingQuote(s, i)
+			if closing > start {
+				return true
+			}
+			i = closing - 1
+		}
+	}
+	return false
+}
+
+// findWord returns the index of the first case-insensitive occurrence of
+// word in s that is not inside quotes, or -1.
+func findWord(s string, word string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			i = closingQuote(s, i) - 1
+			continue
+		}
+
+		end := i + len(word)
+		if end > len(s) || !strings.EqualFold(s[i:end], word) {
+			continue
+		}
+		startsWord := i == 0 || s[i-1] == ' '
+		endsWord := end == len(s) || s[end] == ' ' || s[end] == ','
+		if startsWord && endsWord {
+			return i
+		}
+	}
+	return -1
+}
+
+// columnDefault returns the DEFAULT value of a column definition as written,
+// quotes included, or an empty string when there is none.
+func columnDefault(other string) string {
+	i := findWord(other, "DEFAULT")
+	if i < 0 {
+		return ""
+	}
+	return leadingValue(strings.TrimLeft(other[i+len("DEFAULT"):], " "))
+}
+
+// leadingValue returns the first value of s, keeping quoted strings and
+// parenthesised expressions whole.
+func leadingValue(s string) string {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			i = closingQuote(s, i) - 1
+		case '(':
+			if end := matchingParen(s, i); end >= 0 {
+				i = end
+			}
+		case ' ', ',':
+			return s[:i]
+		}
+	}
+	return s
+}
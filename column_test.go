@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFindWord(t *testing.T) {
+	tests := []struct {
+		s    string
+		word string
+		want int
+	}{
+		{"NOT NULL DEFAULT '0'", "DEFAULT", 9},
+		{"not null default '0'", "DEFAULT", 9},
+		{"COMMENT 'NOT NULL' DEFAULT NULL", "NOT NULL", -1},
+		{"DEFAULTS", "DEFAULT", -1},
+		// ı is 2 bytes and upper-cases to the 1 byte I
+		{"COMMENT 'ıııııııııııı' NOT NULL", "NOT NULL", 35},
+		{"COMMENT 'ıııııııııııı'", "NOT NULL", -1},
+		{"ııııııı", "DEFAULT", -1},
+	}
+	for _, tt := range tests {
+		if got := findWord(tt.s, tt.word); got != tt.want {
+			t.Errorf("findWord(%q, %q) = %d, want %d", tt.s, tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCompareNonASCIIComment(t *testing.T) {
+	schemaA := "CREATE TABLE `t` (\n  `name` varchar(10) COMMENT 'ıııııııııııı' NOT NULL\n) ENGINE=InnoDB;\n"
+	schemaB := "CREATE TABLE `t` (\n  `name` varchar(10) COMMENT 'ıııııııııııı' NOT NULL DEFAULT 'x'\n) ENGINE=InnoDB;\n"
+
+	diffs := compareTables(mustParseTables(t, schemaA), mustParseTables(t, schemaB), CompareOptions{CompareColumns: map[string]bool{"default": true}})
+	if len(diffs) != 1 || diffs[0].Type != WrongColumnOther || diffs[0].B != "DEFAULT 'x'" {
+		t.Errorf("got %+v, want one WRONG_COLUMN_OTHER for the default", diffs)
+	}
+}
+
+func TestVisibilityInComment(t *testing.T) {
+
+	tests := []struct {
+		other     string
+		invisible bool
+		want      string
+	}{
+		{"NOT NULL COMMENT 'hide when invisible'", false, "NOT NULL COMMENT 'hide when invisible'"},
+		{"NOT NULL COMMENT 'hide when visible'", false, "NOT NULL COMMENT 'hide when visible'"},
+		{"NOT NULL INVISIBLE COMMENT 'shown when visible'", true, "NOT NULL COMMENT 'shown when visible'"},
+		{"NOT NULL COMMENT 'invisible' /*!80023 INVISIBLE */", true, "NOT NULL COMMENT 'invisible'"},
+	}
+	for _, tt := range tests {
+		column := newColumn("flag", "tinyint(1)", tt.other, 0)
+		if column.Invisible != tt.invisible || column.Other != tt.want {
+			t.Errorf("newColumn(%q): got invisible %v, other %q, want %v, %q", tt.other, column.Invisible, column.Other, tt.invisible, tt.want)
+		}
+	}
+
+	schema := "CREATE TABLE `t` (\n  `flag` tinyint(1) NOT NULL COMMENT '%s'\n) ENGINE=InnoDB;\n"
+	tablesA := mustParseTables(t, fmt.Sprintf(schema, "hide when invisible"))
+	tablesB := mustParseTables(t, fmt.Sprintf(schema, "hide when visible"))
+	diffs := compareTables(tablesA, tablesB, CompareOptions{})
+	if len(diffs) != 1 || diffs[0].Type != WrongColumnOther || diffs[0].B != "NOT NULL COMMENT 'hide when visible'" {
+		t.Errorf("got %+v, want one WRONG_COLUMN_OTHER for the comment", diffs)
+	}
+}
+
+func TestColumnFormatInComment(t *testing.T) {
+
+	column := newColumn("code", "char(8)", "NOT NULL /*!50606 COLUMN_FORMAT DYNAMIC */ COMMENT 'was COLUMN_FORMAT FIXED'", 0)
+	if column.ColumnFormat != "DYNAMIC" || column.Other != "NOT NULL COMMENT 'was COLUMN_FORMAT FIXED'" {
+		t.Errorf("got format %q, other %q", column.ColumnFormat, column.Other)
+	}
+
+	schema := "CREATE TABLE `t` (\n  `code` char(8) NOT NULL COMMENT 'set COLUMN_FORMAT %s later'\n) ENGINE=ndbcluster;\n"
+	tablesA := mustParseTables(t, fmt.Sprintf(schema, "FIXED"))
+	tablesB := mustParseTables(t, fmt.Sprintf(schema, "DYNAMIC"))
+	for _, opts := range []CompareOptions{{}, {IncludeNDBAttrs: true}} {
+		diffs := compareTables(tablesA, tablesB, opts)
+		if len(diffs) != 1 || diffs[0].Type != WrongColumnOther {
+			t.Errorf("IncludeNDBAttrs %v: got %+v, want one WRONG_COLUMN_OTHER for the comment", opts.IncludeNDBAttrs, diffs)
+		}
+	}
+}
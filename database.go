@@ -0,0 +1,127 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// readDatabaseSchema connects to a live MySQL database and returns the
+// SHOW CREATE TABLE output of every base table in it, and the SHOW CREATE
+// VIEW, SHOW CREATE TRIGGER, SHOW CREATE PROCEDURE and SHOW CREATE FUNCTION
+// output of every view, trigger and routine, laid out like a mysqldump file
+// so it goes through the same parser.
+func readDatabaseSchema(dsn string) (string, error) {
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SHOW FULL TABLES WHERE Table_type IN ('BASE TABLE', 'VIEW')")
+	if err != nil {
+		return "", err
+	}
+	var tableNames, viewNames []string
+	for rows.Next() {
+		var name, tableType string
+		if err := rows.Scan(&name, &tableType); err != nil {
+			rows.Close()
+			return "", err
+		}
+		if tableType == "VIEW" {
+			viewNames = append(viewNames, name)
+		} else {
+			tableNames = append(tableNames, name)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, name := range tableNames {
+		var table, createTable string
+		query := fmt.Sprintf("SHOW CREATE TABLE `%s`", strings.ReplaceAll(name, "`", "``"))
+		if err := db.QueryRow(query).Scan(&table, &createTable); err != nil {
+			return "", fmt.Errorf("%s: %v", name, err)
+		}
+		fmt.Fprintf(&b, "%s;\n\n", createTable)
+	}
+	for _, name := range viewNames {
+		var view, createStatement, charset, collation string
+		query := fmt.Sprintf("SHOW CREATE VIEW `%s`", strings.ReplaceAll(name, "`", "``"))
+		if err := db.QueryRow(query).Scan(&view, &createStatement, &charset, &collation); err != nil {
+			return "", fmt.Errorf("%s: %v", name, err)
+		}
+		fmt.Fprintf(&b, "%s;\n\n", createStatement)
+	}
+
+	triggerNames, err := queryNames(db, "SELECT TRIGGER_NAME FROM information_schema.TRIGGERS WHERE TRIGGER_SCHEMA = DATABASE() ORDER BY TRIGGER_NAME")
+	if err != nil {
+		return "", err
+	}
+	for _, name := range triggerNames {
+		var trigger, sqlMode, createStatement, charset, collation, databaseCollation string
+		var created sql.NullString
+		query := fmt.Sprintf("SHOW CREATE TRIGGER `%s`", strings.ReplaceAll(name, "`", "``"))
+		if err := db.QueryRow(query).Scan(&trigger, &sqlMode, &createStatement, &charset, &collation, &databaseCollation, &created); err != nil {
+			return "", fmt.Errorf("%s: %v", name, err)
+		}
+		fmt.Fprintf(&b, "DELIMITER ;;\n%s;;\nDELIMITER ;\n\n", createStatement)
+	}
+
+	for _, kind := range []string{"PROCEDURE", "FUNCTION"} {
+		routineNames, err := queryNames(db, fmt.Sprintf("SELECT ROUTINE_NAME FROM information_schema.ROUTINES WHERE ROUTINE_SCHEMA = DATABASE() AND ROUTINE_TYPE = '%s' ORDER BY ROUTINE_NAME", kind))
+		if err != nil {
+			return "", err
+		}
+		for _, name := range routineNames {
+			var routine, sqlMode, charset, collation, databaseCollation string
+			// NULL without the privileges to see the definition
+			var createStatement sql.NullString
+			query := fmt.Sprintf("SHOW CREATE %s `%s`", kind, strings.ReplaceAll(name, "`", "``"))
+			if err := db.QueryRow(query).Scan(&routine, &sqlMode, &createStatement, &charset, &collation, &databaseCollation); err != nil {
+				return "", fmt.Errorf("%s: %v", name, err)
+			}
+			if createStatement.Valid {
+				fmt.Fprintf(&b, "DELIMITER ;;\n%s;;\nDELIMITER ;\n\n", createStatement.String)
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// queryNames returns the first column of every row of the query.
+func queryNames(db *sql.DB, query string) ([]string, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// dsnLabel names a database in the output in place of a file path, without
+// its password.
+func dsnLabel(dsn string) string {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "database"
+	}
+	cfg.Passwd = ""
+	return cfg.FormatDSN()
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// definitionScanner reads a MySQL dump line by line like bufio.Scanner, but
+// joins the lines of a column or key definition split across several lines,
+// e.g. long ENUM lists, into one. Inside a CREATE TABLE block a definition
+// ends on a trailing comma or right before the closing parenthesis, once its
+// parentheses and quotes are balanced. Lines are read without the \r of
+// dumps made on Windows, which bufio.ScanLines drops, nor the byte order
+// mark some editors start UTF-8 files with.
+type definitionScanner struct {
+	scanner  *bufio.Scanner
+	physical int
+	unread   *string
+	inTable  bool
+	// set for the output of alembic upgrade --sql
+	alembic *alembicFilter
+
+	line int
+	text string
+}
+
+func newDefinitionScanner(r io.Reader, alembic bool) *definitionScanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	s := &definitionScanner{scanner: scanner}
+	if alembic {
+		s.alembic = &alembicFilter{}
+	}
+	return s
+}
+
+// Line returns the line number the current definition starts at.
+func (s *definitionScanner) Line() int {
+	return s.line
+}
+
+func (s *definitionScanner) Text() string {
+	return s.text
+}
+
+func (s *definitionScanner) Err() error {
+	return s.scanner.Err()
+}
+
+func (s *definitionScanner) read() (string, bool) {
+	if s.unread != nil {
+		line := *s.unread
+		s.unread = nil
+		return line, true
+	}
+	for s.scanner.Scan() {
+		s.physical++
+		line := s.scanner.Text()
+		if s.physical == 1 {
+			line = strings.TrimPrefix(line, "\xef\xbb\xbf")
+		}
+		if s.alembic != nil {
+			var keep bool
+			if line, keep = s.alembic.line(line); !keep {
+				continue
+			}
+		}
+		return line, true
+	}
+	return "", false
+}
+
+func (s *definitionScanner) Scan() bool {
+
+	var pending strings.Builder
+	depth, inQuote := 0, false
+	emit := func(line int, text string) bool {
+		s.line, s.text = line, text
+		return true
+	}
+
+	start := 0
+	for {
+		line, ok := s.read()
+		if !ok {
+			if pending.Len() > 0 {
+				return emit(start, pending.String())
+			}
+			return false
+		}
+		if !inQuote {
+			line = stripComments(line)
+		}
+		trimmed := strings.Trim(line, " ")
+
+		if !s.inTable {
+			s.inTable = hasPrefixWords(strings.Fields(trimmed), "CREATE", "TABLE") && strings.HasSuffix(trimmed, "(")
+			return emit(s.physical, line)
+		}
+
+		if pending.Len() == 0 {
+			if strings.HasPrefix(trimmed, ")") {
+				s.inTable = false
+				return emit(s.physical, line)
+			}
+			if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+				return emit(s.physical, line)
+			}
+		} else if strings.HasPrefix(trimmed, ")") && depth == 0 && !inQuote {
+			s.unread = &line
+			return emit(start, pending.String())
+		}
+
+		switch {
+		case pending.Len() == 0:
+			start = s.physical
+		case inQuote:
+			pending.WriteString("\n")
+		case depth == 0:
+			pending.WriteString(" ")
+		}
+		pending.WriteString(trimmed)
+
+		depth, inQuote = nesting(pending.String())
+		if depth == 0 && !inQuote && strings.HasSuffix(trimmed, ",") {
+			return emit(start, pending.String())
+		}
+	}
+}
+
+// nesting returns how many parentheses are left open at the end of s and
+// whether it ends inside a quoted string.
+func nesting(s string) (int, bool) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			end := closingQuote(s, i)
+			if end == len(s) && (end-1 == i || s[end-1] != s[i]) {
+				return depth, true
+			}
+			i = end - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+	}
+	return depth, false
+}
+
+// stripComments removes a trailing -- comment and the /* ... */ comments
+// opening and closing on the same line, outside quotes. MySQL executable
+// comments, /*! ... */, are kept since the server runs what they contain.
+func stripComments(line string) string {
+
+	var b strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\'', c == '"', c == '`':
+			end := closingQuote(line, i)
+			b.WriteString(line[i:end])
+			i = end - 1
+		case strings.HasPrefix(line[i:], "--") && (i+2 == len(line) || line[i+2] == ' ' || line[i+2] == '\t'):
+			return strings.TrimRight(b.String(), " \t")
+		case strings.HasPrefix(line[i:], "/*") && !strings.HasPrefix(line[i:], "/*!"):
+			end := strings.Index(line[i+2:], "*/")
+			if end < 0 {
+				b.WriteString(line[i:])
+				return b.String()
+			}
+			i += end + 3
+			// the comment separates tokens like a single space
+			for i+1 < len(line) && line[i+1] == ' ' {
+				i++
+			}
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), " ") {
+				b.WriteByte(' ')
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
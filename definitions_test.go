@@ -0,0 +1,37 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const definitionsSchema = "CREATE TABLE `users` (\n" +
+	"  `id` int NOT NULL AUTO_INCREMENT,\n" +
+	"  `status` enum('active',\n" +
+	"    'banned') NOT NULL DEFAULT 'active',\n" +
+	"  `email` varchar(255) DEFAULT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  KEY `idx_email` (`email`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n"
+
+func TestParseCRLFLineEndings(t *testing.T) {
+
+	want := mustParseTables(t, definitionsSchema)
+	got := mustParseTables(t, strings.ReplaceAll(definitionsSchema, "\n", "\r\n"))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CRLF schema parsed as %+v, want %+v", got, want)
+	}
+	if diffs := compareTables(want, got, CompareOptions{}); len(diffs) > 0 {
+		t.Errorf("unexpected diffs: %+v", diffs)
+	}
+}
+
+func TestParseByteOrderMark(t *testing.T) {
+
+	want := mustParseTables(t, definitionsSchema)
+	got := mustParseTables(t, "\xef\xbb\xbf"+definitionsSchema)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("schema with a byte order mark parsed as %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+// DiffType is the type of a Diff, one of the diff type constants.
+type DiffType string
+
+// DiffSet is a list of diffs with query methods for programmatic use. The
+// filtering methods keep the order of the diffs.
+type DiffSet []Diff
+
+// ByType returns the diffs of the given type.
+func (s DiffSet) ByType(t DiffType) DiffSet {
+	return s.filter(func(d Diff) bool {
+		return d.Type == string(t)
+	})
+}
+
+// ByTable returns the diffs of the given table and of its columns, indexes
+// and constraints.
+func (s DiffSet) ByTable(name string) DiffSet {
+	return s.filter(func(d Diff) bool {
+		tableName, _ := splitTarget(d.Target)
+		return tableName == name
+	})
+}
+
+// Breaking returns the diffs classified as BREAKING.
+func (s DiffSet) Breaking() DiffSet {
+	return s.filter(isBreaking)
+}
+
+func (s DiffSet) Count() int {
+	return len(s)
+}
+
+// GroupByTable returns the diffs by table name.
+func (s DiffSet) GroupByTable() map[string]DiffSet {
+	groups := make(map[string]DiffSet)
+	for _, d := range s {
+		tableName, _ := splitTarget(d.Target)
+		groups[tableName] = append(groups[tableName], d)
+	}
+	return groups
+}
+
+// GroupByType returns the diffs by diff type.
+func (s DiffSet) GroupByType() map[DiffType]DiffSet {
+	groups := make(map[DiffType]DiffSet)
+	for _, d := range s {
+		groups[DiffType(d.Type)] = append(groups[DiffType(d.Type)], d)
+	}
+	return groups
+}
+
+func (s DiffSet) filter(keep func(Diff) bool) DiffSet {
+	var res DiffSet
+	for _, d := range s {
+		if keep(d) {
+			res = append(res, d)
+		}
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Graphviz colours of the tables and foreign keys only in one schema.
+const (
+	dotColorA = "red"
+	dotColorB = "blue"
+)
+
+// renderDot renders the tables of both schemas and their foreign keys as a
+// Graphviz digraph: a node per table and an edge, labelled with its columns,
+// from every foreign key to the table it references. Tables and foreign keys
+// only in A are red, those only in B blue.
+func renderDot(tablesA map[string]Table, tablesB map[string]Table) string {
+
+	names := unionKeys(tablesA, tablesB)
+
+	var b strings.Builder
+	b.WriteString("digraph schema {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %s%s;\n", dotID(name), dotColor(tablesA, tablesB, name))
+	}
+
+	edgesA, edgesB := dotEdges(tablesA), dotEdges(tablesB)
+	var keys []string
+	for key := range edgesA {
+		keys = append(keys, key)
+	}
+	for key := range edgesB {
+		if _, inA := edgesA[key]; !inA {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	if len(keys) > 0 {
+		b.WriteString("\n")
+	}
+	for _, key := range keys {
+		edge, inA := edgesA[key]
+		_, inB := edgesB[key]
+		if !inA {
+			edge = edgesB[key]
+		}
+		attributes := fmt.Sprintf("label=%s", dotID(edge.columns))
+		switch {
+		case !inB:
+			attributes += ", color=" + dotColorA + ", fontcolor=" + dotColorA
+		case !inA:
+			attributes += ", color=" + dotColorB + ", fontcolor=" + dotColorB
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotID(edge.from), dotID(edge.to), attributes)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+type dotEdge struct {
+	from    string
+	to      string
+	columns string
+}
+
+// dotEdges returns the foreign keys of the tables, keyed by the table, the
+// columns and the referenced table.
+func dotEdges(tables map[string]Table) map[string]dotEdge {
+	edges := make(map[string]dotEdge)
+	for name, table := range tables {
+		for _, constraint := range table.SortedConstraints() {
+			if constraint.Type != "FOREIGN" {
+				continue
+			}
+			to := referencedTable(constraint)
+			if to == "" {
+				continue
+			}
+			columns := strings.Split(constraint.ColumnName, ",")
+			for i, column := range columns {
+				columns[i] = unquoteIdentifier(strings.TrimSpace(column))
+			}
+			edge := dotEdge{from: name, to: to, columns: strings.Join(columns, ", ")}
+			edges[edge.from+"\x00"+edge.columns+"\x00"+edge.to] = edge
+		}
+	}
+	return edges
+}
+
+// dotColor returns the attributes of a table only in one of the schemas.
+func dotColor(tablesA map[string]Table, tablesB map[string]Table, name string) string {
+	_, inA := tablesA[name]
+	_, inB := tablesB[name]
+	switch {
+	case !inB:
+		return " [color=" + dotColorA + ", fontcolor=" + dotColorA + "]"
+	case !inA:
+		return " [color=" + dotColorB + ", fontcolor=" + dotColorB + "]"
+	}
+	return ""
+}
+
+// dotID quotes a table name or label as a Graphviz ID.
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	quotedIdentifier  = regexp.MustCompile("^`[^`]+`$")
+	columnTypeSyntax  = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\(.*\))?$`)
+	tableOptionSyntax = regexp.MustCompile(`^[A-Z_]+=('[^']*'|[A-Za-z0-9_]+)$`)
+)
+
+// alter clauses the migration generator emits
+var alterClausePrefixes = [][]string{
+	{"ADD", "COLUMN"},
+	{"MODIFY", "COLUMN"},
+	{"DROP", "COLUMN"},
+	{"DROP", "INDEX"},
+	{"DROP", "PRIMARY", "KEY"},
+	{"DROP", "FOREIGN", "KEY"},
+	{"DROP", "CONSTRAINT"},
+	{"RENAME", "INDEX"},
+	{"ADD", "KEY"},
+	{"ADD", "FULLTEXT", "KEY"},
+	{"ADD", "SPATIAL", "KEY"},
+	{"ADD", "PRIMARY", "KEY"},
+	{"ADD", "UNIQUE", "KEY"},
+	{"ADD", "CONSTRAINT"},
+}
+
+type MigrationError struct {
+	Statement string
+	Reason    string
+}
+
+func (e MigrationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, firstLine(e.Statement))
+}
+
+// dryRunMigration checks every generated statement against the subset of
+// the MySQL grammar the migration generator produces, so a bug in the
+// generator shows up before the migration reaches a database.
+func dryRunMigration(migration Migration) []MigrationError {
+
+	var errs []MigrationError
+	for _, statement := range migrationStatements(migration) {
+		if err := validateStatement(statement); err != "" {
+			errs = append(errs, MigrationError{Statement: statement, Reason: err})
+		}
+	}
+	return errs
+}
+
+func validateStatement(statement string) string {
+
+	var lines []string
+	for _, line := range strings.Split(statement, "\n") {
+		if !strings.HasPrefix(line, "--") {
+			lines = append(lines, line)
+		}
+	}
+	statement = strings.TrimSpace(strings.Join(lines, "\n"))
+
+	if !strings.HasSuffix(statement, ";") {
+		return "missing terminating semicolon"
+	}
+	statement = strings.TrimSuffix(statement, ";")
+	if depth, inQuote := nesting(statement); depth != 0 || inQuote {
+		return "unbalanced parentheses or quotes"
+	}
+
+	words := strings.Fields(statement)
+	switch {
+	case hasPrefixWords(words, "DROP", "TABLE"):
+		if len(words) != 3 || !quotedIdentifier.MatchString(words[2]) {
+			return "malformed DROP TABLE"
+		}
+	case hasPrefixWords(words, "ALTER", "TABLE"):
+		if len(words) < 4 || !quotedIdentifier.MatchString(words[2]) {
+			return "malformed ALTER TABLE"
+		}
+		clauses := strings.TrimSpace(statement[strings.Index(statement, words[2])+len(words[2]):])
+		for _, clause := range splitTopLevel(clauses, ',') {
+			if err := validateAlterClause(clause); err != "" {
+				return err
+			}
+		}
+	case hasPrefixWords(words, "CREATE", "TABLE"):
+		if len(words) < 4 || !quotedIdentifier.MatchString(words[2]) {
+			return "malformed CREATE TABLE"
+		}
+		open := strings.IndexByte(statement, '(')
+		end := matchingParen(statement, open)
+		if open < 0 || end < 0 {
+			return "malformed CREATE TABLE body"
+		}
+		for _, option := range strings.Fields(statement[end+1:]) {
+			if !tableOptionSyntax.MatchString(option) {
+				return "invalid table option: " + option
+			}
+		}
+		for _, item := range splitTopLevel(statement[open+1:end], ',') {
+			itemWords := strings.Fields(item)
+			switch {
+			case hasPrefixWords(itemWords, "PRIMARY", "KEY"),
+				hasPrefixWords(itemWords, "UNIQUE", "KEY"),
+				hasPrefixWords(itemWords, "KEY"),
+				hasPrefixWords(itemWords, "FULLTEXT", "KEY"),
+				hasPrefixWords(itemWords, "SPATIAL", "KEY"),
+				hasPrefixWords(itemWords, "CONSTRAINT"):
+				if !strings.Contains(item, "(") {
+					return "key without columns: " + item
+				}
+			default:
+				if err := validateColumnDefinition(item); err != "" {
+					return err
+				}
+			}
+		}
+	default:
+		return "unexpected statement"
+	}
+
+	return ""
+}
+
+func validateAlterClause(clause string) string {
+
+	words := strings.Fields(clause)
+	if len(words) == 1 && tableOptionSyntax.MatchString(words[0]) {
+		return ""
+	}
+	for _, prefix := range alterClausePrefixes {
+		if !hasPrefixWords(words, prefix...) {
+			continue
+		}
+
+		rest := strings.TrimSpace(strings.Join(words[len(prefix):], " "))
+		switch prefix[0] + " " + prefix[1] {
+		case "ADD COLUMN", "MODIFY COLUMN":
+			return validateColumnDefinition(rest)
+		case "RENAME INDEX":
+			if len(words) != 5 || strings.ToUpper(words[3]) != "TO" {
+				return "malformed RENAME INDEX: " + clause
+			}
+		case "DROP PRIMARY":
+			if rest != "" {
+				return "unexpected tokens after DROP PRIMARY KEY: " + clause
+			}
+		default:
+			if rest == "" {
+				return "missing name: " + clause
+			}
+		}
+		return ""
+	}
+	return "unknown ALTER TABLE clause: " + clause
+}
+
+func validateColumnDefinition(def string) string {
+	words := strings.Fields(def)
+	if len(words) < 2 || !quotedIdentifier.MatchString(words[0]) {
+		return "malformed column definition: " + def
+	}
+
+	columnType := leadingValue(strings.TrimSpace(def[len(words[0]):]))
+	if !columnTypeSyntax.MatchString(columnType) {
+		return "invalid column type: " + def
+	}
+	return ""
+}
+
+// applyMigration returns the tables the migration turns the given ones into,
+// by parsing every generated statement back with the MySQL parser. Comparing
+// the result with the target schema catches statements that are valid SQL
+// but do not do what the diff asked for.
+func applyMigration(tables map[string]Table, migration Migration) (map[string]Table, error) {
+
+	applied := make(map[string]Table, len(tables))
+	for name, table := range tables {
+		parsed, err := parseDefinitions(name, createTableSQL(table))
+		if err != nil {
+			return nil, err
+		}
+		applied[name] = parsed
+	}
+
+	for _, statement := range migrationStatements(migration) {
+		var lines []string
+		for _, line := range strings.Split(statement, "\n") {
+			if !strings.HasPrefix(line, "--") {
+				lines = append(lines, line)
+			}
+		}
+		statement = strings.TrimSuffix(strings.TrimSpace(strings.Join(lines, "\n")), ";")
+
+		words := strings.Fields(statement)
+		if len(words) < 3 {
+			return nil, fmt.Errorf("unexpected statement: %s", firstLine(statement))
+		}
+		tableName := strings.Trim(words[2], "`")
+
+		switch {
+		case hasPrefixWords(words, "CREATE", "TABLE"):
+			table, err := parseDefinitions(tableName, statement+";")
+			if err != nil {
+				return nil, err
+			}
+			applied[tableName] = table
+		case hasPrefixWords(words, "DROP", "TABLE"):
+			delete(applied, tableName)
+		case hasPrefixWords(words, "ALTER", "TABLE"):
+			table, exists := applied[tableName]
+			if !exists {
+				return nil, fmt.Errorf("ALTER TABLE of a missing table: %s", tableName)
+			}
+			clauses := strings.TrimSpace(statement[strings.Index(statement, words[2])+len(words[2]):])
+			for _, clause := range splitTopLevel(clauses, ',') {
+				if err := applyAlterClause(&table, strings.TrimSpace(clause)); err != nil {
+					return nil, fmt.Errorf("%s: %v", tableName, err)
+				}
+			}
+			applied[tableName] = table
+		default:
+			return nil, fmt.Errorf("unexpected statement: %s", firstLine(statement))
+		}
+	}
+
+	return applied, nil
+}
+
+func applyAlterClause(table *Table, clause string) error {
+
+	words := strings.Fields(clause)
+	name := func(i int) string {
+		if len(words) <= i {
+			return ""
+		}
+		return strings.Trim(words[i], "`")
+	}
+
+	switch {
+	case len(words) == 1 && tableOptionSyntax.MatchString(words[0]):
+		table.Options = applyTableOption(table.Options, clause)
+	case hasPrefixWords(words, "ADD", "COLUMN"), hasPrefixWords(words, "MODIFY", "COLUMN"):
+		def := strings.TrimSpace(clause[strings.Index(clause, words[1])+len(words[1]):])
+		parsed, err := parseDefinitions(table.Name, fmt.Sprintf("CREATE TABLE `%s` (\n  %s\n);", table.Name, def))
+		if err != nil {
+			return err
+		}
+		for _, column := range parsed.Columns {
+			ordinal := len(table.Columns)
+			if existing, exists := table.Columns[column.Name]; exists {
+				ordinal = existing.Ordinal
+			}
+			column.Ordinal = ordinal
+			table.Columns[column.Name] = column
+		}
+	case hasPrefixWords(words, "ADD"):
+		def := strings.TrimSpace(clause[len(words[0]):])
+		parsed, err := parseDefinitions(table.Name, fmt.Sprintf("CREATE TABLE `%s` (\n  %s\n);", table.Name, def))
+		if err != nil {
+			return err
+		}
+		for columnName, index := range parsed.Indexes {
+			table.Indexes[columnName] = index
+		}
+		for _, constraint := range parsed.SortedConstraints() {
+			addConstraint(*table, constraint)
+		}
+	case hasPrefixWords(words, "DROP", "COLUMN"):
+		delete(table.Columns, name(2))
+	case hasPrefixWords(words, "DROP", "PRIMARY", "KEY"):
+		removeConstraints(*table, func(c Constraint) bool { return c.Type == "PRIMARY" })
+	case hasPrefixWords(words, "DROP", "FOREIGN", "KEY"):
+		removeConstraints(*table, func(c Constraint) bool { return c.Type == "FOREIGN" && c.Name == name(3) })
+	case hasPrefixWords(words, "DROP", "CONSTRAINT"):
+		removeConstraints(*table, func(c Constraint) bool { return c.Name == name(2) })
+	case hasPrefixWords(words, "DROP", "INDEX"):
+		for columnName, index := range table.Indexes {
+			if index.Name == name(2) {
+				delete(table.Indexes, columnName)
+			}
+		}
+		removeConstraints(*table, func(c Constraint) bool { return c.Type == "UNIQUE" && c.Name == name(2) })
+	case hasPrefixWords(words, "RENAME", "INDEX"):
+		for columnName, index := range table.Indexes {
+			if index.Name == name(2) {
+				index.Name = name(4)
+				table.Indexes[columnName] = index
+			}
+		}
+		for _, constraints := range table.Constraints {
+			if unique, exists := constraints["UNIQUE"]; exists && unique.Name == name(2) {
+				unique.Name = name(4)
+				constraints["UNIQUE"] = unique
+			}
+		}
+	default:
+		return fmt.Errorf("unknown ALTER TABLE clause: %s", clause)
+	}
+	return nil
+}
+
+func removeConstraints(table Table, match func(Constraint) bool) {
+	for columnName, constraints := range table.Constraints {
+		for constraintType, constraint := range constraints {
+			if match(constraint) {
+				delete(constraints, constraintType)
+			}
+		}
+		if len(constraints) == 0 {
+			delete(table.Constraints, columnName)
+		}
+	}
+}
+
+// parseDefinitions parses a single CREATE TABLE statement and returns its
+// table under the given name.
+func parseDefinitions(tableName string, statement string) (Table, error) {
+
+	tables, _, err := parseTables(strings.NewReader(statement+"\n"), true, "")
+	if err != nil {
+		return Table{}, err
+	}
+	for _, table := range tables {
+		table.Name = tableName
+		return table, nil
+	}
+	return Table{}, fmt.Errorf("cannot parse: %s", firstLine(statement))
+}
@@ -0,0 +1,41 @@
+CREATE TABLE alembic_version (
+    version_num VARCHAR(32) NOT NULL, 
+    CONSTRAINT alembic_version_pkc PRIMARY KEY (version_num)
+);
+
+-- Running upgrade  -> 1975ea83b712
+
+CREATE TABLE account (
+	id INTEGER NOT NULL AUTO_INCREMENT, 
+	name VARCHAR(100) NOT NULL, 
+	description VARCHAR(200), 
+	last_transaction_date DATETIME, 
+	PRIMARY KEY (id)
+);
+
+INSERT INTO alembic_version (version_num) VALUES ('1975ea83b712');
+
+-- Running upgrade 1975ea83b712 -> 27c6a30d7c24
+
+CREATE TABLE `order` (
+	id INTEGER NOT NULL AUTO_INCREMENT, 
+	account_id INTEGER NOT NULL, 
+	amount NUMERIC(12, 2) NOT NULL, 
+	PRIMARY KEY (id), 
+	FOREIGN KEY(account_id) REFERENCES account (id)
+);
+
+UPDATE alembic_version SET version_num='27c6a30d7c24' WHERE alembic_version.version_num = '1975ea83b712';
+
+-- Running upgrade 27c6a30d7c24 -> ae1027a6acf
+
+CREATE TABLE invoice (
+	id INTEGER NOT NULL AUTO_INCREMENT, 
+	order_id INTEGER NOT NULL, 
+	issued_at DATETIME NOT NULL, 
+	PRIMARY KEY (id)
+);
+
+UPDATE alembic_version SET version_num='ae1027a6acf' WHERE alembic_version.version_num = '27c6a30d7c24';
+
+COMMIT;
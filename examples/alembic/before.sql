@@ -0,0 +1,29 @@
+CREATE TABLE alembic_version (
+    version_num VARCHAR(32) NOT NULL, 
+    CONSTRAINT alembic_version_pkc PRIMARY KEY (version_num)
+);
+
+-- Running upgrade  -> 1975ea83b712
+
+CREATE TABLE account (
+	id INTEGER NOT NULL AUTO_INCREMENT, 
+	name VARCHAR(50) NOT NULL, 
+	description VARCHAR(200), 
+	PRIMARY KEY (id)
+);
+
+INSERT INTO alembic_version (version_num) VALUES ('1975ea83b712');
+
+-- Running upgrade 1975ea83b712 -> 27c6a30d7c24
+
+CREATE TABLE `order` (
+	id INTEGER NOT NULL AUTO_INCREMENT, 
+	account_id INTEGER NOT NULL, 
+	amount NUMERIC(10, 2) NOT NULL, 
+	PRIMARY KEY (id), 
+	FOREIGN KEY(account_id) REFERENCES account (id)
+);
+
+UPDATE alembic_version SET version_num='27c6a30d7c24' WHERE alembic_version.version_num = '1975ea83b712';
+
+COMMIT;
@@ -0,0 +1,25 @@
+CREATE TABLE public.customers (
+	id INT8 NOT NULL DEFAULT unique_rowid(),
+	email STRING NOT NULL,
+	name STRING NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now():::TIMESTAMPTZ,
+	CONSTRAINT "primary" PRIMARY KEY (id ASC),
+	UNIQUE INDEX customers_email_key (email ASC),
+	FAMILY "primary" (id, email, created_at),
+	FAMILY profile (name)
+);
+
+CREATE TABLE public.orders (
+	customer_id INT8 NOT NULL,
+	id INT8 NOT NULL DEFAULT unique_rowid(),
+	total DECIMAL(12,2) NOT NULL,
+	notes STRING NULL FAMILY notes,
+	CONSTRAINT "primary" PRIMARY KEY (customer_id ASC, id ASC),
+	INDEX orders_total_idx (total DESC),
+	FAMILY "primary" (customer_id, id, total)
+);
+
+ALTER TABLE public.orders ADD CONSTRAINT fk_customer_id_ref_customers FOREIGN KEY (customer_id) REFERENCES public.customers(id) ON DELETE CASCADE;
+
+-- Validate foreign key constraints. These can fail if there was unvalidated data during the dump.
+ALTER TABLE public.orders VALIDATE CONSTRAINT fk_customer_id_ref_customers;
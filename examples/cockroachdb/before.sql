@@ -0,0 +1,24 @@
+CREATE TABLE public.customers (
+	id INT8 NOT NULL DEFAULT unique_rowid(),
+	email STRING NOT NULL,
+	name STRING NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now():::TIMESTAMPTZ,
+	CONSTRAINT "primary" PRIMARY KEY (id ASC),
+	UNIQUE INDEX customers_email_key (email ASC),
+	FAMILY "primary" (id, email, name, created_at)
+);
+
+CREATE TABLE public.orders (
+	customer_id INT8 NOT NULL,
+	id INT8 NOT NULL DEFAULT unique_rowid(),
+	total DECIMAL(10,2) NOT NULL,
+	notes STRING NULL,
+	CONSTRAINT "primary" PRIMARY KEY (customer_id ASC, id ASC),
+	INDEX orders_total_idx (total DESC),
+	FAMILY "primary" (customer_id, id, total, notes)
+) INTERLEAVE IN PARENT public.customers (customer_id);
+
+ALTER TABLE public.orders ADD CONSTRAINT fk_customer_id_ref_customers FOREIGN KEY (customer_id) REFERENCES public.customers(id);
+
+-- Validate foreign key constraints. These can fail if there was unvalidated data during the dump.
+ALTER TABLE public.orders VALIDATE CONSTRAINT fk_customer_id_ref_customers;
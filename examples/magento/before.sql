@@ -0,0 +1,3630 @@
+-- MySQL dump 10.13  Distrib 8.0.35, for Linux (x86_64)
+--
+-- Magento 2 database schema
+
+DROP TABLE IF EXISTS `admin_attribute`;
+CREATE TABLE `admin_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` mediumtext,
+  `base_currency_code` text,
+  `updated_at` text,
+  `state` int(11) DEFAULT NULL,
+  `website_id` int(11) DEFAULT NULL,
+  `increment_id` varchar(64) NOT NULL,
+  `qty` datetime DEFAULT NULL,
+  `price` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `ADMIN_ATTRIBUTE_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `ADMIN_ATTRIBUTE_STATE` (`state`),
+  KEY `ADMIN_ATTRIBUTE_INCREMENT_ID` (`increment_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `admin_entity`;
+CREATE TABLE `admin_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` mediumtext,
+  `value` text,
+  `type_id` text,
+  `updated_at` varchar(64) NOT NULL,
+  `price` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `admin_entity_text`;
+CREATE TABLE `admin_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `level` mediumtext,
+  `title` decimal(20,6) DEFAULT NULL,
+  `customer_id` varchar(64) NOT NULL,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `value` mediumtext,
+  `position` text,
+  `sku` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `ADMIN_ENTITY_TEXT_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `admin_label`;
+CREATE TABLE `admin_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `price` varchar(255) DEFAULT NULL,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `path` mediumtext,
+  `code` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `ADMIN_LABEL_PRICE` (`price`),
+  KEY `ADMIN_LABEL_PATH` (`path`),
+  KEY `ADMIN_LABEL_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `admin_status`;
+CREATE TABLE `admin_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` varchar(64) NOT NULL,
+  `sku` int(10) unsigned NOT NULL,
+  `position` mediumtext,
+  `sort_order` text,
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `title` mediumtext,
+  `base_currency_code` text,
+  `parent_id` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `ADMIN_STATUS_POSITION` (`position`),
+  KEY `ADMIN_STATUS_QTY` (`qty`),
+  KEY `ADMIN_STATUS_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `ADMIN_STATUS_PARENT_ID` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `authorization_entity`;
+CREATE TABLE `authorization_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` int(10) unsigned NOT NULL,
+  `level` datetime DEFAULT NULL,
+  `content` varchar(255) DEFAULT NULL,
+  `sku` text,
+  `qty` decimal(20,6) DEFAULT NULL,
+  `type_id` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `AUTHORIZATION_ENTITY_QTY` (`qty`),
+  CONSTRAINT `AUTHORIZATION_ENTITY_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `authorization_entity_decimal`;
+CREATE TABLE `authorization_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` varchar(64) NOT NULL,
+  `website_id` varchar(255) DEFAULT NULL,
+  `type_id` datetime DEFAULT NULL,
+  `status` datetime DEFAULT NULL,
+  `grand_total` decimal(20,6) DEFAULT NULL,
+  `is_active` mediumtext,
+  `value` int(10) unsigned NOT NULL,
+  `state` varchar(255) DEFAULT NULL,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `AUTHORIZATION_ENTITY_DECIMAL_TITLE` (`title`),
+  KEY `AUTHORIZATION_ENTITY_DECIMAL_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `authorization_entity_int`;
+CREATE TABLE `authorization_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` int(11) DEFAULT NULL,
+  `type_id` varchar(64) NOT NULL,
+  `base_currency_code` int(11) DEFAULT NULL,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  `status` datetime DEFAULT NULL,
+  `increment_id` varchar(64) NOT NULL,
+  `path` text,
+  `attribute_id` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `AUTHORIZATION_ENTITY_INT_TYPE_ID` (`type_id`),
+  KEY `AUTHORIZATION_ENTITY_INT_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `AUTHORIZATION_ENTITY_INT_INCREMENT_ID` (`increment_id`),
+  KEY `AUTHORIZATION_ENTITY_INT_ATTRIBUTE_ID` (`attribute_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `authorization_entity_text`;
+CREATE TABLE `authorization_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` varchar(255) DEFAULT NULL,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `created_at` datetime DEFAULT NULL,
+  `is_active` int(11) DEFAULT NULL,
+  `label` text,
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type_id` int(10) unsigned NOT NULL,
+  `attribute_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `AUTHORIZATION_ENTITY_TEXT_STATE` (`state`),
+  KEY `AUTHORIZATION_ENTITY_TEXT_SORT_ORDER` (`sort_order`),
+  KEY `AUTHORIZATION_ENTITY_TEXT_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `authorization_link`;
+CREATE TABLE `authorization_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` tinyint(1) NOT NULL DEFAULT '1',
+  `content` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `level` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `AUTHORIZATION_LINK_CONTENT` (`content`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `captcha_entity_text`;
+CREATE TABLE `captcha_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` text,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `base_currency_code` decimal(20,6) DEFAULT NULL,
+  `price` int(11) DEFAULT NULL,
+  `updated_at` tinyint(1) NOT NULL DEFAULT '1',
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `increment_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `value` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CAPTCHA_ENTITY_TEXT_ATTRIBUTE_ID` (`attribute_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `captcha_index`;
+CREATE TABLE `captcha_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` varchar(255) DEFAULT NULL,
+  `type_id` varchar(255) DEFAULT NULL,
+  `email` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CAPTCHA_INDEX_STORE_ID` (`store_id`),
+  KEY `CAPTCHA_INDEX_UPDATED_AT` (`updated_at`),
+  KEY `CAPTCHA_INDEX_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `captcha_item`;
+CREATE TABLE `captcha_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` text,
+  `code` decimal(20,6) DEFAULT NULL,
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `value` tinyint(1) NOT NULL DEFAULT '1',
+  `position` decimal(20,6) DEFAULT NULL,
+  `attribute_id` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `CAPTCHA_ITEM_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `captcha_link`;
+CREATE TABLE `captcha_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` datetime DEFAULT NULL,
+  `updated_at` int(10) unsigned NOT NULL,
+  `attribute_id` varchar(255) DEFAULT NULL,
+  `content` varchar(64) NOT NULL,
+  `position` decimal(20,6) DEFAULT NULL,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `code` mediumtext,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `store_id` datetime DEFAULT NULL,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CAPTCHA_LINK_STATE` (`state`),
+  KEY `CAPTCHA_LINK_UPDATED_AT` (`updated_at`),
+  KEY `CAPTCHA_LINK_POSITION` (`position`),
+  KEY `CAPTCHA_LINK_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `captcha_status`;
+CREATE TABLE `captcha_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` varchar(255) DEFAULT NULL,
+  `code` datetime DEFAULT NULL,
+  `title` int(10) unsigned NOT NULL,
+  `email` varchar(255) DEFAULT NULL,
+  `increment_id` text,
+  `position` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` int(11) DEFAULT NULL,
+  `website_id` varchar(64) NOT NULL,
+  `created_at` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CAPTCHA_STATUS_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_category_item`;
+CREATE TABLE `catalog_category_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `value` varchar(255) DEFAULT NULL,
+  `website_id` datetime DEFAULT NULL,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sku` mediumtext,
+  `path` mediumtext,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `position` datetime DEFAULT NULL,
+  `type_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_CATEGORY_ITEM_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_category_label`;
+CREATE TABLE `catalog_category_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` tinyint(1) NOT NULL DEFAULT '1',
+  `grand_total` decimal(20,6) DEFAULT NULL,
+  `qty` int(10) unsigned NOT NULL,
+  `store_id` text,
+  `type_id` varchar(255) DEFAULT NULL,
+  `price` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_CATEGORY_LABEL_LEVEL` (`level`),
+  KEY `CATALOG_CATEGORY_LABEL_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_category_link`;
+CREATE TABLE `catalog_category_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` int(10) unsigned NOT NULL,
+  `email` mediumtext,
+  `value` varchar(64) NOT NULL,
+  `price` datetime DEFAULT NULL,
+  `content` varchar(64) NOT NULL,
+  `base_currency_code` int(11) DEFAULT NULL,
+  `label` text,
+  `sort_order` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_CATEGORY_LINK_VALUE` (`value`),
+  KEY `CATALOG_CATEGORY_LINK_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_category_relation`;
+CREATE TABLE `catalog_category_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `parent_id` int(11) DEFAULT NULL,
+  `title` datetime DEFAULT NULL,
+  `attribute_id` int(11) DEFAULT NULL,
+  `path` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_CATEGORY_RELATION_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `CATALOG_CATEGORY_RELATION_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_category_website`;
+CREATE TABLE `catalog_category_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` datetime DEFAULT NULL,
+  `label` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` tinyint(1) NOT NULL DEFAULT '1',
+  `base_currency_code` int(10) unsigned NOT NULL,
+  `content` int(10) unsigned NOT NULL,
+  `state` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status` text,
+  `is_active` varchar(255) DEFAULT NULL,
+  `price` int(11) DEFAULT NULL,
+  `updated_at` varchar(64) NOT NULL,
+  `customer_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_CATEGORY_WEBSITE_LABEL` (`label`),
+  KEY `CATALOG_CATEGORY_WEBSITE_INCREMENT_ID` (`increment_id`),
+  KEY `CATALOG_CATEGORY_WEBSITE_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `CATALOG_CATEGORY_WEBSITE_CONTENT` (`content`),
+  KEY `CATALOG_CATEGORY_WEBSITE_IS_ACTIVE` (`is_active`),
+  KEY `CATALOG_CATEGORY_WEBSITE_PRICE` (`price`),
+  KEY `CATALOG_CATEGORY_WEBSITE_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_product_entity_text`;
+CREATE TABLE `catalog_product_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` tinyint(1) NOT NULL DEFAULT '1',
+  `price` datetime DEFAULT NULL,
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `status` decimal(20,6) DEFAULT NULL,
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `store_id` tinyint(1) NOT NULL DEFAULT '1',
+  `label` varchar(64) NOT NULL,
+  `website_id` datetime DEFAULT NULL,
+  `customer_id` varchar(255) DEFAULT NULL,
+  `code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `title` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_PRODUCT_ENTITY_TEXT_WEBSITE_ID` (`website_id`),
+  KEY `CATALOG_PRODUCT_ENTITY_TEXT_CUSTOMER_ID` (`customer_id`),
+  KEY `CATALOG_PRODUCT_ENTITY_TEXT_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_product_grid`;
+CREATE TABLE `catalog_product_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `is_active` int(10) unsigned NOT NULL,
+  `updated_at` text,
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_PRODUCT_GRID_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_product_index`;
+CREATE TABLE `catalog_product_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` varchar(64) NOT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `code` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_product_option`;
+CREATE TABLE `catalog_product_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `value` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` varchar(255) DEFAULT NULL,
+  `updated_at` int(11) DEFAULT NULL,
+  `status` tinyint(1) NOT NULL DEFAULT '1',
+  `code` decimal(20,6) DEFAULT NULL,
+  `increment_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_PRODUCT_OPTION_PARENT_ID` (`parent_id`),
+  KEY `CATALOG_PRODUCT_OPTION_INCREMENT_ID` (`increment_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalog_product_relation`;
+CREATE TABLE `catalog_product_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `state` int(11) DEFAULT NULL,
+  `position` varchar(255) DEFAULT NULL,
+  `content` int(11) DEFAULT NULL,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `parent_id` varchar(255) DEFAULT NULL,
+  `path` int(10) unsigned NOT NULL,
+  `website_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status` varchar(255) DEFAULT NULL,
+  `updated_at` decimal(20,6) DEFAULT NULL,
+  `store_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOG_PRODUCT_RELATION_POSITION` (`position`),
+  KEY `CATALOG_PRODUCT_RELATION_CONTENT` (`content`),
+  KEY `CATALOG_PRODUCT_RELATION_PARENT_ID` (`parent_id`),
+  KEY `CATALOG_PRODUCT_RELATION_STORE_ID` (`store_id`),
+  CONSTRAINT `CATALOG_PRODUCT_RELATION_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cataloginventory_entity_int`;
+CREATE TABLE `cataloginventory_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` mediumtext,
+  `attribute_id` tinyint(1) NOT NULL DEFAULT '1',
+  `created_at` int(10) unsigned NOT NULL,
+  `type_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` int(10) unsigned NOT NULL,
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` datetime DEFAULT NULL,
+  `status` int(10) unsigned NOT NULL,
+  `is_active` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGINVENTORY_ENTITY_INT_INCREMENT_ID` (`increment_id`),
+  KEY `CATALOGINVENTORY_ENTITY_INT_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cataloginventory_grid`;
+CREATE TABLE `cataloginventory_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` int(10) unsigned NOT NULL,
+  `value` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` text,
+  `sku` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGINVENTORY_GRID_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `CATALOGINVENTORY_GRID_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cataloginventory_index`;
+CREATE TABLE `cataloginventory_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `attribute_id` datetime DEFAULT NULL,
+  `status` int(11) DEFAULT NULL,
+  `state` mediumtext,
+  `price` varchar(255) DEFAULT NULL,
+  `sku` varchar(64) NOT NULL,
+  `customer_id` varchar(64) NOT NULL,
+  `title` int(10) unsigned NOT NULL,
+  `type_id` text,
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `created_at` text,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGINVENTORY_INDEX_STATE` (`state`),
+  KEY `CATALOGINVENTORY_INDEX_TYPE_ID` (`type_id`),
+  KEY `CATALOGINVENTORY_INDEX_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cataloginventory_label`;
+CREATE TABLE `cataloginventory_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` datetime DEFAULT NULL,
+  `position` varchar(64) NOT NULL,
+  `label` varchar(64) NOT NULL,
+  `email` datetime DEFAULT NULL,
+  `type_id` int(11) DEFAULT NULL,
+  `created_at` int(10) unsigned NOT NULL,
+  `level` int(10) unsigned NOT NULL,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGINVENTORY_LABEL_TYPE_ID` (`type_id`),
+  KEY `CATALOGINVENTORY_LABEL_CODE` (`code`),
+  CONSTRAINT `CATALOGINVENTORY_LABEL_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cataloginventory_website`;
+CREATE TABLE `cataloginventory_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` mediumtext,
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `price` datetime DEFAULT NULL,
+  `base_currency_code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `website_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogrule_attribute`;
+CREATE TABLE `catalogrule_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` decimal(20,6) DEFAULT NULL,
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `email` datetime DEFAULT NULL,
+  `label` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `customer_id` text,
+  `title` int(11) DEFAULT NULL,
+  `level` datetime DEFAULT NULL,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status` int(10) unsigned NOT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGRULE_ATTRIBUTE_SORT_ORDER` (`sort_order`),
+  KEY `CATALOGRULE_ATTRIBUTE_CUSTOMER_ID` (`customer_id`),
+  KEY `CATALOGRULE_ATTRIBUTE_TITLE` (`title`),
+  KEY `CATALOGRULE_ATTRIBUTE_LEVEL` (`level`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogrule_grid`;
+CREATE TABLE `catalogrule_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `attribute_id` int(10) unsigned NOT NULL,
+  `store_id` mediumtext,
+  `value` int(10) unsigned NOT NULL,
+  `increment_id` varchar(64) NOT NULL,
+  `base_currency_code` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGRULE_GRID_SKU` (`sku`),
+  KEY `CATALOGRULE_GRID_STORE_ID` (`store_id`),
+  KEY `CATALOGRULE_GRID_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogrule_index`;
+CREATE TABLE `catalogrule_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sort_order` int(11) DEFAULT NULL,
+  `increment_id` int(10) unsigned NOT NULL,
+  `website_id` datetime DEFAULT NULL,
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `price` datetime DEFAULT NULL,
+  `qty` int(10) unsigned NOT NULL,
+  `sku` varchar(255) DEFAULT NULL,
+  `content` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGRULE_INDEX_SORT_ORDER` (`sort_order`),
+  KEY `CATALOGRULE_INDEX_WEBSITE_ID` (`website_id`),
+  KEY `CATALOGRULE_INDEX_PRICE` (`price`),
+  KEY `CATALOGRULE_INDEX_CONTENT` (`content`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogrule_option`;
+CREATE TABLE `catalogrule_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` datetime DEFAULT NULL,
+  `store_id` int(11) DEFAULT NULL,
+  `customer_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attribute_id` int(11) DEFAULT NULL,
+  `website_id` varchar(255) DEFAULT NULL,
+  `email` int(10) unsigned NOT NULL,
+  `qty` int(11) DEFAULT NULL,
+  `code` varchar(255) DEFAULT NULL,
+  `content` mediumtext,
+  `created_at` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGRULE_OPTION_CONTENT` (`content`),
+  CONSTRAINT `CATALOGRULE_OPTION_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogrule_tmp`;
+CREATE TABLE `catalogrule_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` int(11) DEFAULT NULL,
+  `label` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type_id` mediumtext,
+  `customer_id` mediumtext,
+  `value` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `grand_total` varchar(255) DEFAULT NULL,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `attribute_id` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGRULE_TMP_LABEL` (`label`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogsearch_entity_datetime`;
+CREATE TABLE `catalogsearch_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` int(11) DEFAULT NULL,
+  `customer_id` decimal(20,6) DEFAULT NULL,
+  `grand_total` varchar(64) NOT NULL,
+  `price` int(11) DEFAULT NULL,
+  `content` varchar(64) NOT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `qty` varchar(64) NOT NULL,
+  `created_at` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGSEARCH_ENTITY_DATETIME_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogsearch_entity_varchar`;
+CREATE TABLE `catalogsearch_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `updated_at` datetime DEFAULT NULL,
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` int(10) unsigned NOT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `attribute_id` varchar(64) NOT NULL,
+  `price` int(10) unsigned NOT NULL,
+  `status` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGSEARCH_ENTITY_VARCHAR_UPDATED_AT` (`updated_at`),
+  KEY `CATALOGSEARCH_ENTITY_VARCHAR_QTY` (`qty`),
+  KEY `CATALOGSEARCH_ENTITY_VARCHAR_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogsearch_grid`;
+CREATE TABLE `catalogsearch_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` mediumtext,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `store_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `value` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogsearch_log`;
+CREATE TABLE `catalogsearch_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `qty` mediumtext,
+  `level` int(11) DEFAULT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `grand_total` int(11) DEFAULT NULL,
+  `content` int(10) unsigned NOT NULL,
+  `title` varchar(64) NOT NULL,
+  `value` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGSEARCH_LOG_LEVEL` (`level`),
+  KEY `CATALOGSEARCH_LOG_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `CATALOGSEARCH_LOG_POSITION` (`position`),
+  KEY `CATALOGSEARCH_LOG_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `catalogsearch_tmp`;
+CREATE TABLE `catalogsearch_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `content` int(11) DEFAULT NULL,
+  `sort_order` int(10) unsigned NOT NULL,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` mediumtext,
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CATALOGSEARCH_TMP_CONTENT` (`content`),
+  KEY `CATALOGSEARCH_TMP_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `checkout_entity_decimal`;
+CREATE TABLE `checkout_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` varchar(255) DEFAULT NULL,
+  `state` text,
+  `store_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `checkout_grid`;
+CREATE TABLE `checkout_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `code` tinyint(1) NOT NULL DEFAULT '1',
+  `value` datetime DEFAULT NULL,
+  `parent_id` text,
+  `store_id` int(11) DEFAULT NULL,
+  `sort_order` varchar(255) DEFAULT NULL,
+  `created_at` int(11) DEFAULT NULL,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `label` int(10) unsigned NOT NULL,
+  `type_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` int(10) unsigned NOT NULL,
+  `price` datetime DEFAULT NULL,
+  `title` text,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `checkout_index`;
+CREATE TABLE `checkout_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` datetime DEFAULT NULL,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` decimal(20,6) DEFAULT NULL,
+  `level` text,
+  `status` int(10) unsigned NOT NULL,
+  `price` varchar(64) NOT NULL,
+  `parent_id` tinyint(1) NOT NULL DEFAULT '1',
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CHECKOUT_INDEX_EMAIL` (`email`),
+  KEY `CHECKOUT_INDEX_LEVEL` (`level`),
+  KEY `CHECKOUT_INDEX_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `checkout_label`;
+CREATE TABLE `checkout_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` mediumtext,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `parent_id` int(11) DEFAULT NULL,
+  `label` int(10) unsigned NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `checkout_price`;
+CREATE TABLE `checkout_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` text,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `is_active` int(11) DEFAULT NULL,
+  `email` datetime DEFAULT NULL,
+  `title` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CHECKOUT_PRICE_UPDATED_AT` (`updated_at`),
+  KEY `CHECKOUT_PRICE_IS_ACTIVE` (`is_active`),
+  KEY `CHECKOUT_PRICE_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cms_attribute`;
+CREATE TABLE `cms_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` int(11) DEFAULT NULL,
+  `content` mediumtext,
+  `parent_id` int(10) unsigned NOT NULL,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `code` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CMS_ATTRIBUTE_PARENT_ID` (`parent_id`),
+  KEY `CMS_ATTRIBUTE_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cms_entity_datetime`;
+CREATE TABLE `cms_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` mediumtext,
+  `title` varchar(255) DEFAULT NULL,
+  `content` varchar(64) NOT NULL,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `CMS_ENTITY_DATETIME_TITLE` (`title`),
+  KEY `CMS_ENTITY_DATETIME_SKU` (`sku`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cms_entity_text`;
+CREATE TABLE `cms_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` text,
+  `sort_order` int(10) unsigned NOT NULL,
+  `status` int(11) DEFAULT NULL,
+  `position` datetime DEFAULT NULL,
+  `increment_id` text,
+  `content` varchar(64) NOT NULL,
+  `customer_id` text,
+  `store_id` int(10) unsigned NOT NULL,
+  `grand_total` int(10) unsigned NOT NULL,
+  `updated_at` tinyint(1) NOT NULL DEFAULT '1',
+  `code` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CMS_ENTITY_TEXT_CREATED_AT` (`created_at`),
+  KEY `CMS_ENTITY_TEXT_SORT_ORDER` (`sort_order`),
+  KEY `CMS_ENTITY_TEXT_STATUS` (`status`),
+  KEY `CMS_ENTITY_TEXT_POSITION` (`position`),
+  KEY `CMS_ENTITY_TEXT_INCREMENT_ID` (`increment_id`),
+  CONSTRAINT `CMS_ENTITY_TEXT_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cms_entity_varchar`;
+CREATE TABLE `cms_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` int(11) DEFAULT NULL,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` text,
+  `title` int(11) DEFAULT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cms_grid`;
+CREATE TABLE `cms_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` int(10) unsigned NOT NULL,
+  `parent_id` mediumtext,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `price` varchar(64) NOT NULL,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CMS_GRID_TITLE` (`title`),
+  KEY `CMS_GRID_STATUS` (`status`),
+  KEY `CMS_GRID_PRICE` (`price`),
+  KEY `CMS_GRID_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `core_attribute`;
+CREATE TABLE `core_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` decimal(20,6) DEFAULT NULL,
+  `store_id` int(11) DEFAULT NULL,
+  `content` int(10) unsigned NOT NULL,
+  `is_active` mediumtext,
+  `website_id` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `CORE_ATTRIBUTE_STORE_ID` (`store_id`),
+  KEY `CORE_ATTRIBUTE_CONTENT` (`content`),
+  KEY `CORE_ATTRIBUTE_WEBSITE_ID` (`website_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `core_entity`;
+CREATE TABLE `core_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` text,
+  `status` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `price` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `CORE_ENTITY_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `core_entity_decimal`;
+CREATE TABLE `core_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `price` mediumtext,
+  `sku` int(10) unsigned NOT NULL,
+  `updated_at` int(11) DEFAULT NULL,
+  `type_id` int(11) DEFAULT NULL,
+  `website_id` varchar(64) NOT NULL,
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `customer_id` int(10) unsigned NOT NULL,
+  `path` datetime DEFAULT NULL,
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CORE_ENTITY_DECIMAL_CUSTOMER_ID` (`customer_id`),
+  KEY `CORE_ENTITY_DECIMAL_PATH` (`path`),
+  KEY `CORE_ENTITY_DECIMAL_LABEL` (`label`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `core_item`;
+CREATE TABLE `core_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` varchar(64) NOT NULL,
+  `email` varchar(255) DEFAULT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `content` int(10) unsigned NOT NULL,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `qty` int(11) DEFAULT NULL,
+  `is_active` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` varchar(255) DEFAULT NULL,
+  `parent_id` datetime DEFAULT NULL,
+  `base_currency_code` text,
+  `customer_id` mediumtext,
+  `increment_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CORE_ITEM_VALUE` (`value`),
+  KEY `CORE_ITEM_QTY` (`qty`),
+  KEY `CORE_ITEM_PATH` (`path`),
+  KEY `CORE_ITEM_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `core_status`;
+CREATE TABLE `core_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` datetime DEFAULT NULL,
+  `increment_id` int(11) DEFAULT NULL,
+  `level` varchar(255) DEFAULT NULL,
+  `created_at` mediumtext,
+  `email` text,
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CORE_STATUS_CREATED_AT` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cron_entity_int`;
+CREATE TABLE `cron_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` int(11) DEFAULT NULL,
+  `position` mediumtext,
+  `attribute_id` text,
+  `is_active` varchar(64) NOT NULL,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `customer_id` varchar(64) NOT NULL,
+  `sort_order` datetime DEFAULT NULL,
+  `label` int(10) unsigned NOT NULL,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `value` datetime DEFAULT NULL,
+  `status` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `store_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CRON_ENTITY_INT_SKU` (`sku`),
+  KEY `CRON_ENTITY_INT_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `CRON_ENTITY_INT_WEBSITE_ID` (`website_id`),
+  KEY `CRON_ENTITY_INT_SORT_ORDER` (`sort_order`),
+  KEY `CRON_ENTITY_INT_LABEL` (`label`),
+  KEY `CRON_ENTITY_INT_STATUS` (`status`),
+  KEY `CRON_ENTITY_INT_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cron_grid`;
+CREATE TABLE `cron_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` mediumtext,
+  `sort_order` decimal(20,6) DEFAULT NULL,
+  `qty` text,
+  `value` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CRON_GRID_VALUE` (`value`),
+  CONSTRAINT `CRON_GRID_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cron_index`;
+CREATE TABLE `cron_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` text,
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `title` varchar(255) DEFAULT NULL,
+  `status` mediumtext,
+  `type_id` int(11) DEFAULT NULL,
+  `increment_id` tinyint(1) NOT NULL DEFAULT '1',
+  `code` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` mediumtext,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `CRON_INDEX_SKU` (`sku`),
+  KEY `CRON_INDEX_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cron_log`;
+CREATE TABLE `cron_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` int(11) DEFAULT NULL,
+  `base_currency_code` varchar(64) NOT NULL,
+  `state` int(11) DEFAULT NULL,
+  `attribute_id` int(11) DEFAULT NULL,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `sort_order` varchar(255) DEFAULT NULL,
+  `title` int(10) unsigned NOT NULL,
+  `email` varchar(64) NOT NULL,
+  `content` mediumtext,
+  `type_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CRON_LOG_STATE` (`state`),
+  KEY `CRON_LOG_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `CRON_LOG_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `cron_tmp`;
+CREATE TABLE `cron_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `status` tinyint(1) NOT NULL DEFAULT '1',
+  `store_id` varchar(255) DEFAULT NULL,
+  `title` tinyint(1) NOT NULL DEFAULT '1',
+  `customer_id` varchar(255) DEFAULT NULL,
+  `sku` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CRON_TMP_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_address_entity_datetime`;
+CREATE TABLE `customer_address_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` decimal(20,6) DEFAULT NULL,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` int(10) unsigned NOT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sort_order` text,
+  `updated_at` datetime DEFAULT NULL,
+  `type_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `state` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_ADDRESS_ENTITY_DATETIME_CONTENT` (`content`),
+  KEY `CUSTOMER_ADDRESS_ENTITY_DATETIME_SORT_ORDER` (`sort_order`),
+  CONSTRAINT `CUSTOMER_ADDRESS_ENTITY_DATETIME_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_address_grid`;
+CREATE TABLE `customer_address_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `parent_id` text,
+  `increment_id` int(10) unsigned NOT NULL,
+  `base_currency_code` tinyint(1) NOT NULL DEFAULT '1',
+  `store_id` text,
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attribute_id` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_ADDRESS_GRID_PARENT_ID` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_address_relation`;
+CREATE TABLE `customer_address_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` mediumtext,
+  `parent_id` int(10) unsigned NOT NULL,
+  `sku` text,
+  `attribute_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_ADDRESS_RELATION_PARENT_ID` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_address_status`;
+CREATE TABLE `customer_address_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` int(11) DEFAULT NULL,
+  `content` decimal(20,6) DEFAULT NULL,
+  `price` tinyint(1) NOT NULL DEFAULT '1',
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `position` datetime DEFAULT NULL,
+  `updated_at` int(11) DEFAULT NULL,
+  `value` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_ADDRESS_STATUS_CUSTOMER_ID` (`customer_id`),
+  KEY `CUSTOMER_ADDRESS_STATUS_SKU` (`sku`),
+  KEY `CUSTOMER_ADDRESS_STATUS_POSITION` (`position`),
+  KEY `CUSTOMER_ADDRESS_STATUS_UPDATED_AT` (`updated_at`),
+  KEY `CUSTOMER_ADDRESS_STATUS_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_address_website`;
+CREATE TABLE `customer_address_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` decimal(20,6) DEFAULT NULL,
+  `code` text,
+  `content` int(11) DEFAULT NULL,
+  `is_active` text,
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `customer_id` varchar(64) NOT NULL,
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `attribute_id` datetime DEFAULT NULL,
+  `price` int(10) unsigned NOT NULL,
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `qty` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_ADDRESS_WEBSITE_INCREMENT_ID` (`increment_id`),
+  KEY `CUSTOMER_ADDRESS_WEBSITE_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `CUSTOMER_ADDRESS_WEBSITE_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_attribute`;
+CREATE TABLE `customer_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` varchar(64) NOT NULL,
+  `level` int(10) unsigned NOT NULL,
+  `path` tinyint(1) NOT NULL DEFAULT '1',
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `updated_at` datetime DEFAULT NULL,
+  `base_currency_code` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_item`;
+CREATE TABLE `customer_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` tinyint(1) NOT NULL DEFAULT '1',
+  `updated_at` text,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type_id` mediumtext,
+  `parent_id` varchar(64) NOT NULL,
+  `title` decimal(20,6) DEFAULT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sort_order` datetime DEFAULT NULL,
+  `is_active` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sku` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_ITEM_INCREMENT_ID` (`increment_id`),
+  KEY `CUSTOMER_ITEM_PRICE` (`price`),
+  KEY `CUSTOMER_ITEM_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_log`;
+CREATE TABLE `customer_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` varchar(255) DEFAULT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `store_id` mediumtext,
+  `email` decimal(20,6) DEFAULT NULL,
+  `level` mediumtext,
+  `created_at` int(10) unsigned NOT NULL,
+  `price` int(10) unsigned NOT NULL,
+  `position` varchar(255) DEFAULT NULL,
+  `type_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_LOG_WEBSITE_ID` (`website_id`),
+  KEY `CUSTOMER_LOG_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_price`;
+CREATE TABLE `customer_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` int(11) DEFAULT NULL,
+  `is_active` varchar(255) DEFAULT NULL,
+  `parent_id` datetime DEFAULT NULL,
+  `created_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` varchar(64) NOT NULL,
+  `price` text,
+  `level` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_PRICE_IS_ACTIVE` (`is_active`),
+  KEY `CUSTOMER_PRICE_PARENT_ID` (`parent_id`),
+  KEY `CUSTOMER_PRICE_CREATED_AT` (`created_at`),
+  KEY `CUSTOMER_PRICE_TYPE_ID` (`type_id`),
+  KEY `CUSTOMER_PRICE_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `customer_website`;
+CREATE TABLE `customer_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` varchar(64) NOT NULL,
+  `qty` varchar(255) DEFAULT NULL,
+  `sku` int(11) DEFAULT NULL,
+  `updated_at` datetime DEFAULT NULL,
+  `is_active` mediumtext,
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type_id` mediumtext,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `CUSTOMER_WEBSITE_QTY` (`qty`),
+  KEY `CUSTOMER_WEBSITE_SKU` (`sku`),
+  KEY `CUSTOMER_WEBSITE_UPDATED_AT` (`updated_at`),
+  KEY `CUSTOMER_WEBSITE_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `directory_attribute`;
+CREATE TABLE `directory_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` text,
+  `status` tinyint(1) NOT NULL DEFAULT '1',
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `value` varchar(64) NOT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `title` int(11) DEFAULT NULL,
+  `updated_at` mediumtext,
+  `state` mediumtext,
+  `sku` mediumtext,
+  `customer_id` text,
+  `code` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `DIRECTORY_ATTRIBUTE_SORT_ORDER` (`sort_order`),
+  KEY `DIRECTORY_ATTRIBUTE_STATE` (`state`),
+  KEY `DIRECTORY_ATTRIBUTE_CUSTOMER_ID` (`customer_id`),
+  KEY `DIRECTORY_ATTRIBUTE_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `directory_entity_text`;
+CREATE TABLE `directory_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `customer_id` varchar(255) DEFAULT NULL,
+  `content` varchar(255) DEFAULT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `store_id` datetime DEFAULT NULL,
+  `code` text,
+  `sort_order` varchar(64) NOT NULL,
+  `value` varchar(64) NOT NULL,
+  `position` datetime DEFAULT NULL,
+  `attribute_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `DIRECTORY_ENTITY_TEXT_CUSTOMER_ID` (`customer_id`),
+  KEY `DIRECTORY_ENTITY_TEXT_CONTENT` (`content`),
+  KEY `DIRECTORY_ENTITY_TEXT_STORE_ID` (`store_id`),
+  KEY `DIRECTORY_ENTITY_TEXT_ATTRIBUTE_ID` (`attribute_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `directory_item`;
+CREATE TABLE `directory_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` varchar(64) NOT NULL,
+  `status` varchar(64) NOT NULL,
+  `grand_total` datetime DEFAULT NULL,
+  `base_currency_code` varchar(64) NOT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `DIRECTORY_ITEM_UPDATED_AT` (`updated_at`),
+  KEY `DIRECTORY_ITEM_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `directory_store`;
+CREATE TABLE `directory_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` text,
+  `type_id` varchar(255) DEFAULT NULL,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `customer_id` decimal(20,6) DEFAULT NULL,
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `email` varchar(255) DEFAULT NULL,
+  `state` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `DIRECTORY_STORE_EMAIL` (`email`),
+  KEY `DIRECTORY_STORE_STATE` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `directory_tmp`;
+CREATE TABLE `directory_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` mediumtext,
+  `website_id` text,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `type_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `DIRECTORY_TMP_WEBSITE_ID` (`website_id`),
+  KEY `DIRECTORY_TMP_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `downloadable_entity_int`;
+CREATE TABLE `downloadable_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `qty` varchar(255) DEFAULT NULL,
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `status` varchar(255) DEFAULT NULL,
+  `value` text,
+  `level` varchar(64) NOT NULL,
+  `state` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `downloadable_entity_varchar`;
+CREATE TABLE `downloadable_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` varchar(64) NOT NULL,
+  `status` mediumtext,
+  `state` varchar(64) NOT NULL,
+  `is_active` int(10) unsigned NOT NULL,
+  `updated_at` int(10) unsigned NOT NULL,
+  `store_id` varchar(64) NOT NULL,
+  `content` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `DOWNLOADABLE_ENTITY_VARCHAR_SKU` (`sku`),
+  KEY `DOWNLOADABLE_ENTITY_VARCHAR_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `downloadable_label`;
+CREATE TABLE `downloadable_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `parent_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  `email` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `customer_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `DOWNLOADABLE_LABEL_SORT_ORDER` (`sort_order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `downloadable_store`;
+CREATE TABLE `downloadable_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `state` mediumtext,
+  `position` varchar(255) DEFAULT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `created_at` text,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `parent_id` datetime DEFAULT NULL,
+  `title` decimal(20,6) DEFAULT NULL,
+  `email` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `DOWNLOADABLE_STORE_POSITION` (`position`),
+  KEY `DOWNLOADABLE_STORE_CONTENT` (`content`),
+  KEY `DOWNLOADABLE_STORE_PARENT_ID` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `downloadable_website`;
+CREATE TABLE `downloadable_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `email` text,
+  `title` decimal(20,6) DEFAULT NULL,
+  `store_id` varchar(255) DEFAULT NULL,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sort_order` varchar(64) NOT NULL,
+  `customer_id` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `DOWNLOADABLE_WEBSITE_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `DOWNLOADABLE_WEBSITE_STORE_ID` (`store_id`),
+  KEY `DOWNLOADABLE_WEBSITE_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `eav_attribute`;
+CREATE TABLE `eav_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `base_currency_code` mediumtext,
+  `parent_id` varchar(255) DEFAULT NULL,
+  `updated_at` datetime DEFAULT NULL,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `eav_label`;
+CREATE TABLE `eav_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` varchar(255) DEFAULT NULL,
+  `value` int(11) DEFAULT NULL,
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `title` int(10) unsigned NOT NULL,
+  `customer_id` varchar(255) DEFAULT NULL,
+  `base_currency_code` varchar(64) NOT NULL,
+  `attribute_id` varchar(64) NOT NULL,
+  `code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` decimal(20,6) DEFAULT NULL,
+  `is_active` mediumtext,
+  `website_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `EAV_LABEL_CODE` (`code`),
+  KEY `EAV_LABEL_WEBSITE_ID` (`website_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `eav_link`;
+CREATE TABLE `eav_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `value` decimal(20,6) DEFAULT NULL,
+  `sku` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` int(10) unsigned NOT NULL,
+  `content` decimal(20,6) DEFAULT NULL,
+  `qty` int(11) DEFAULT NULL,
+  `base_currency_code` int(10) unsigned NOT NULL,
+  `title` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `EAV_LINK_POSITION` (`position`),
+  KEY `EAV_LINK_UPDATED_AT` (`updated_at`),
+  KEY `EAV_LINK_VALUE` (`value`),
+  KEY `EAV_LINK_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `eav_log`;
+CREATE TABLE `eav_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `parent_id` mediumtext,
+  `increment_id` varchar(64) NOT NULL,
+  `email` tinyint(1) NOT NULL DEFAULT '1',
+  `qty` tinyint(1) NOT NULL DEFAULT '1',
+  `sort_order` text,
+  `label` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `EAV_LOG_PARENT_ID` (`parent_id`),
+  KEY `EAV_LOG_INCREMENT_ID` (`increment_id`),
+  KEY `EAV_LOG_SORT_ORDER` (`sort_order`),
+  KEY `EAV_LOG_LABEL` (`label`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `eav_store`;
+CREATE TABLE `eav_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` varchar(255) DEFAULT NULL,
+  `attribute_id` varchar(255) DEFAULT NULL,
+  `title` decimal(20,6) DEFAULT NULL,
+  `position` varchar(64) NOT NULL,
+  `base_currency_code` text,
+  `sku` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `email_entity_decimal`;
+CREATE TABLE `email_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` varchar(64) NOT NULL,
+  `label` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `is_active` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `email_entity_text`;
+CREATE TABLE `email_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `path` int(11) DEFAULT NULL,
+  `type_id` varchar(255) DEFAULT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  `sort_order` datetime DEFAULT NULL,
+  `value` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `EMAIL_ENTITY_TEXT_PATH` (`path`),
+  KEY `EMAIL_ENTITY_TEXT_LABEL` (`label`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `email_entity_varchar`;
+CREATE TABLE `email_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` int(11) DEFAULT NULL,
+  `parent_id` varchar(255) DEFAULT NULL,
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `status` decimal(20,6) DEFAULT NULL,
+  `price` decimal(20,6) DEFAULT NULL,
+  `attribute_id` varchar(64) NOT NULL,
+  `store_id` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `EMAIL_ENTITY_VARCHAR_PARENT_ID` (`parent_id`),
+  KEY `EMAIL_ENTITY_VARCHAR_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `EMAIL_ENTITY_VARCHAR_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `email_index`;
+CREATE TABLE `email_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` varchar(255) DEFAULT NULL,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  `updated_at` varchar(255) DEFAULT NULL,
+  `value` varchar(255) DEFAULT NULL,
+  `position` int(10) unsigned NOT NULL,
+  `state` decimal(20,6) DEFAULT NULL,
+  `increment_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `created_at` varchar(64) NOT NULL,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `EMAIL_INDEX_STATE` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `email_item`;
+CREATE TABLE `email_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` mediumtext,
+  `code` int(11) DEFAULT NULL,
+  `type_id` int(11) DEFAULT NULL,
+  `grand_total` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `path` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `EMAIL_ITEM_STORE_ID` (`store_id`),
+  CONSTRAINT `EMAIL_ITEM_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `export_entity_text`;
+CREATE TABLE `export_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `parent_id` varchar(64) NOT NULL,
+  `title` mediumtext,
+  `attribute_id` mediumtext,
+  `email` int(10) unsigned NOT NULL,
+  `path` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` varchar(255) DEFAULT NULL,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `value` int(10) unsigned NOT NULL,
+  `price` decimal(20,6) DEFAULT NULL,
+  `customer_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `EXPORT_ENTITY_TEXT_SKU` (`sku`),
+  KEY `EXPORT_ENTITY_TEXT_SORT_ORDER` (`sort_order`),
+  KEY `EXPORT_ENTITY_TEXT_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `export_index`;
+CREATE TABLE `export_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` text,
+  `increment_id` varchar(64) NOT NULL,
+  `updated_at` varchar(64) NOT NULL,
+  `position` varchar(255) DEFAULT NULL,
+  `website_id` varchar(64) NOT NULL,
+  `sort_order` int(11) DEFAULT NULL,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `label` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `EXPORT_INDEX_CUSTOMER_ID` (`customer_id`),
+  KEY `EXPORT_INDEX_INCREMENT_ID` (`increment_id`),
+  KEY `EXPORT_INDEX_SORT_ORDER` (`sort_order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `export_label`;
+CREATE TABLE `export_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` int(10) unsigned NOT NULL,
+  `grand_total` varchar(64) NOT NULL,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `state` varchar(255) DEFAULT NULL,
+  `parent_id` datetime DEFAULT NULL,
+  `increment_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `status` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `EXPORT_LABEL_SKU` (`sku`),
+  KEY `EXPORT_LABEL_PARENT_ID` (`parent_id`),
+  KEY `EXPORT_LABEL_CONTENT` (`content`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `export_log`;
+CREATE TABLE `export_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `qty` varchar(255) DEFAULT NULL,
+  `store_id` varchar(64) NOT NULL,
+  `position` int(11) DEFAULT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `level` text,
+  `increment_id` varchar(64) NOT NULL,
+  `email` int(10) unsigned NOT NULL,
+  `attribute_id` varchar(64) NOT NULL,
+  `is_active` decimal(20,6) DEFAULT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `title` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `EXPORT_LOG_STORE_ID` (`store_id`),
+  KEY `EXPORT_LOG_SORT_ORDER` (`sort_order`),
+  KEY `EXPORT_LOG_LEVEL` (`level`),
+  KEY `EXPORT_LOG_EMAIL` (`email`),
+  KEY `EXPORT_LOG_IS_ACTIVE` (`is_active`),
+  KEY `EXPORT_LOG_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `export_price`;
+CREATE TABLE `export_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `path` int(10) unsigned NOT NULL,
+  `grand_total` varchar(255) DEFAULT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `EXPORT_PRICE_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `googleoptimizer_entity_decimal`;
+CREATE TABLE `googleoptimizer_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` int(11) DEFAULT NULL,
+  `attribute_id` int(11) DEFAULT NULL,
+  `path` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` mediumtext,
+  `sku` datetime DEFAULT NULL,
+  `parent_id` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `GOOGLEOPTIMIZER_ENTITY_DECIMAL_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `GOOGLEOPTIMIZER_ENTITY_DECIMAL_PARENT_ID` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `googleoptimizer_entity_text`;
+CREATE TABLE `googleoptimizer_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` int(11) DEFAULT NULL,
+  `state` varchar(255) DEFAULT NULL,
+  `code` tinyint(1) NOT NULL DEFAULT '1',
+  `increment_id` decimal(20,6) DEFAULT NULL,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` datetime DEFAULT NULL,
+  `title` decimal(20,6) DEFAULT NULL,
+  `is_active` varchar(64) NOT NULL,
+  `attribute_id` varchar(64) NOT NULL,
+  `type_id` mediumtext,
+  `customer_id` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `GOOGLEOPTIMIZER_ENTITY_TEXT_STATE` (`state`),
+  KEY `GOOGLEOPTIMIZER_ENTITY_TEXT_CODE` (`code`),
+  KEY `GOOGLEOPTIMIZER_ENTITY_TEXT_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `googleoptimizer_index`;
+CREATE TABLE `googleoptimizer_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `website_id` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `GOOGLEOPTIMIZER_INDEX_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `googleoptimizer_status`;
+CREATE TABLE `googleoptimizer_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `price` text,
+  `level` varchar(64) NOT NULL,
+  `position` varchar(64) NOT NULL,
+  `content` text,
+  `title` int(11) DEFAULT NULL,
+  `attribute_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` text,
+  `status` decimal(20,6) DEFAULT NULL,
+  `email` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `GOOGLEOPTIMIZER_STATUS_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `googleoptimizer_website`;
+CREATE TABLE `googleoptimizer_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` datetime DEFAULT NULL,
+  `parent_id` int(10) unsigned NOT NULL,
+  `position` varchar(64) NOT NULL,
+  `path` int(11) DEFAULT NULL,
+  `updated_at` mediumtext,
+  `value` text,
+  `sku` text,
+  `label` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `import_entity`;
+CREATE TABLE `import_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` int(11) DEFAULT NULL,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `qty` datetime DEFAULT NULL,
+  `grand_total` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` int(11) DEFAULT NULL,
+  `position` mediumtext,
+  `status` varchar(255) DEFAULT NULL,
+  `parent_id` datetime DEFAULT NULL,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `IMPORT_ENTITY_INCREMENT_ID` (`increment_id`),
+  KEY `IMPORT_ENTITY_PARENT_ID` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `import_entity_decimal`;
+CREATE TABLE `import_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `qty` int(10) unsigned NOT NULL,
+  `status` varchar(64) NOT NULL,
+  `label` varchar(255) DEFAULT NULL,
+  `store_id` mediumtext,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` datetime DEFAULT NULL,
+  `grand_total` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `IMPORT_ENTITY_DECIMAL_QTY` (`qty`),
+  KEY `IMPORT_ENTITY_DECIMAL_SORT_ORDER` (`sort_order`),
+  CONSTRAINT `IMPORT_ENTITY_DECIMAL_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `import_grid`;
+CREATE TABLE `import_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` mediumtext,
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `customer_id` varchar(255) DEFAULT NULL,
+  `store_id` int(11) DEFAULT NULL,
+  `is_active` varchar(64) NOT NULL,
+  `updated_at` int(11) DEFAULT NULL,
+  `grand_total` int(10) unsigned NOT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` varchar(64) NOT NULL,
+  `increment_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `IMPORT_GRID_SORT_ORDER` (`sort_order`),
+  KEY `IMPORT_GRID_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `import_store`;
+CREATE TABLE `import_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `is_active` varchar(255) DEFAULT NULL,
+  `parent_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `attribute_id` mediumtext,
+  `base_currency_code` int(11) DEFAULT NULL,
+  `content` mediumtext,
+  `position` int(10) unsigned NOT NULL,
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `IMPORT_STORE_IS_ACTIVE` (`is_active`),
+  KEY `IMPORT_STORE_SORT_ORDER` (`sort_order`),
+  KEY `IMPORT_STORE_SKU` (`sku`),
+  KEY `IMPORT_STORE_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `IMPORT_STORE_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `import_tmp`;
+CREATE TABLE `import_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` mediumtext,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `state` tinyint(1) NOT NULL DEFAULT '1',
+  `sort_order` text,
+  `value` varchar(64) NOT NULL,
+  `base_currency_code` text,
+  `parent_id` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `IMPORT_TMP_CREATED_AT` (`created_at`),
+  KEY `IMPORT_TMP_SORT_ORDER` (`sort_order`),
+  KEY `IMPORT_TMP_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `indexer_entity_int`;
+CREATE TABLE `indexer_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` mediumtext,
+  `state` varchar(64) NOT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `website_id` varchar(255) DEFAULT NULL,
+  `label` text,
+  `path` varchar(64) NOT NULL,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `is_active` varchar(255) DEFAULT NULL,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `parent_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INDEXER_ENTITY_INT_INCREMENT_ID` (`increment_id`),
+  KEY `INDEXER_ENTITY_INT_WEBSITE_ID` (`website_id`),
+  KEY `INDEXER_ENTITY_INT_PATH` (`path`),
+  KEY `INDEXER_ENTITY_INT_CONTENT` (`content`),
+  CONSTRAINT `INDEXER_ENTITY_INT_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `indexer_index`;
+CREATE TABLE `indexer_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` varchar(255) DEFAULT NULL,
+  `level` datetime DEFAULT NULL,
+  `grand_total` varchar(64) NOT NULL,
+  `is_active` int(11) DEFAULT NULL,
+  `qty` int(11) DEFAULT NULL,
+  `customer_id` decimal(20,6) DEFAULT NULL,
+  `created_at` int(11) DEFAULT NULL,
+  `code` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INDEXER_INDEX_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `indexer_label`;
+CREATE TABLE `indexer_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` mediumtext,
+  `is_active` varchar(64) NOT NULL,
+  `base_currency_code` decimal(20,6) DEFAULT NULL,
+  `price` int(10) unsigned NOT NULL,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INDEXER_LABEL_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `indexer_relation`;
+CREATE TABLE `indexer_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` varchar(64) NOT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` decimal(20,6) DEFAULT NULL,
+  `label` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `code` text,
+  `content` datetime DEFAULT NULL,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `INDEXER_RELATION_VALUE` (`value`),
+  KEY `INDEXER_RELATION_INCREMENT_ID` (`increment_id`),
+  KEY `INDEXER_RELATION_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `indexer_status`;
+CREATE TABLE `indexer_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` mediumtext,
+  `content` decimal(20,6) DEFAULT NULL,
+  `email` datetime DEFAULT NULL,
+  `price` decimal(20,6) DEFAULT NULL,
+  `title` varchar(255) DEFAULT NULL,
+  `created_at` int(10) unsigned NOT NULL,
+  `parent_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `is_active` int(10) unsigned NOT NULL,
+  `qty` decimal(20,6) DEFAULT NULL,
+  `store_id` varchar(255) DEFAULT NULL,
+  `grand_total` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INDEXER_STATUS_CREATED_AT` (`created_at`),
+  KEY `INDEXER_STATUS_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `integration_attribute`;
+CREATE TABLE `integration_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` int(10) unsigned NOT NULL,
+  `is_active` tinyint(1) NOT NULL DEFAULT '1',
+  `customer_id` text,
+  `parent_id` text,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `updated_at` varchar(255) DEFAULT NULL,
+  `level` varchar(255) DEFAULT NULL,
+  `qty` decimal(20,6) DEFAULT NULL,
+  `path` mediumtext,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `content` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INTEGRATION_ATTRIBUTE_CUSTOMER_ID` (`customer_id`),
+  KEY `INTEGRATION_ATTRIBUTE_LEVEL` (`level`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `integration_entity_text`;
+CREATE TABLE `integration_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` mediumtext,
+  `created_at` int(11) DEFAULT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `sort_order` text,
+  `title` varchar(255) DEFAULT NULL,
+  `parent_id` int(11) DEFAULT NULL,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `INTEGRATION_ENTITY_TEXT_SORT_ORDER` (`sort_order`),
+  KEY `INTEGRATION_ENTITY_TEXT_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `integration_link`;
+CREATE TABLE `integration_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` int(11) DEFAULT NULL,
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `code` text,
+  `type_id` varchar(255) DEFAULT NULL,
+  `status` datetime DEFAULT NULL,
+  `store_id` mediumtext,
+  `attribute_id` int(10) unsigned NOT NULL,
+  `is_active` int(10) unsigned NOT NULL,
+  `label` int(10) unsigned NOT NULL,
+  `path` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `INTEGRATION_LINK_SKU` (`sku`),
+  KEY `INTEGRATION_LINK_ATTRIBUTE_ID` (`attribute_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `integration_log`;
+CREATE TABLE `integration_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` tinyint(1) NOT NULL DEFAULT '1',
+  `status` varchar(64) NOT NULL,
+  `qty` int(10) unsigned NOT NULL,
+  `content` decimal(20,6) DEFAULT NULL,
+  `parent_id` tinyint(1) NOT NULL DEFAULT '1',
+  `base_currency_code` text,
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `sort_order` decimal(20,6) DEFAULT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INTEGRATION_LOG_STATUS` (`status`),
+  KEY `INTEGRATION_LOG_QTY` (`qty`),
+  KEY `INTEGRATION_LOG_PARENT_ID` (`parent_id`),
+  KEY `INTEGRATION_LOG_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `integration_relation`;
+CREATE TABLE `integration_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` varchar(255) DEFAULT NULL,
+  `content` int(10) unsigned NOT NULL,
+  `code` varchar(64) NOT NULL,
+  `position` int(11) DEFAULT NULL,
+  `email` int(10) unsigned NOT NULL,
+  `price` int(11) DEFAULT NULL,
+  `sort_order` datetime DEFAULT NULL,
+  `path` decimal(20,6) DEFAULT NULL,
+  `qty` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INTEGRATION_RELATION_CODE` (`code`),
+  KEY `INTEGRATION_RELATION_EMAIL` (`email`),
+  KEY `INTEGRATION_RELATION_PRICE` (`price`),
+  KEY `INTEGRATION_RELATION_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_source_entity_text`;
+CREATE TABLE `inventory_source_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `is_active` int(11) DEFAULT NULL,
+  `customer_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `parent_id` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_SOURCE_ENTITY_TEXT_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_source_entity_varchar`;
+CREATE TABLE `inventory_source_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime DEFAULT NULL,
+  `email` int(11) DEFAULT NULL,
+  `title` int(11) DEFAULT NULL,
+  `sku` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_SOURCE_ENTITY_VARCHAR_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_source_label`;
+CREATE TABLE `inventory_source_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attribute_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `is_active` int(10) unsigned NOT NULL,
+  `store_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_SOURCE_LABEL_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_source_price`;
+CREATE TABLE `inventory_source_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` varchar(255) DEFAULT NULL,
+  `base_currency_code` int(11) DEFAULT NULL,
+  `status` int(10) unsigned NOT NULL,
+  `created_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `value` varchar(255) DEFAULT NULL,
+  `website_id` text,
+  `sku` varchar(64) NOT NULL,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_SOURCE_PRICE_CREATED_AT` (`created_at`),
+  KEY `INVENTORY_SOURCE_PRICE_VALUE` (`value`),
+  KEY `INVENTORY_SOURCE_PRICE_WEBSITE_ID` (`website_id`),
+  KEY `INVENTORY_SOURCE_PRICE_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_source_relation`;
+CREATE TABLE `inventory_source_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `path` int(11) DEFAULT NULL,
+  `status` text,
+  `title` varchar(255) DEFAULT NULL,
+  `increment_id` int(10) unsigned NOT NULL,
+  `store_id` int(10) unsigned NOT NULL,
+  `content` decimal(20,6) DEFAULT NULL,
+  `type_id` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `state` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `website_id` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_SOURCE_RELATION_CONTENT` (`content`),
+  KEY `INVENTORY_SOURCE_RELATION_TYPE_ID` (`type_id`),
+  KEY `INVENTORY_SOURCE_RELATION_SKU` (`sku`),
+  CONSTRAINT `INVENTORY_SOURCE_RELATION_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_stock_entity`;
+CREATE TABLE `inventory_stock_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` mediumtext,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` datetime DEFAULT NULL,
+  `code` varchar(255) DEFAULT NULL,
+  `parent_id` varchar(255) DEFAULT NULL,
+  `updated_at` mediumtext,
+  `title` tinyint(1) NOT NULL DEFAULT '1',
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_STOCK_ENTITY_SORT_ORDER` (`sort_order`),
+  KEY `INVENTORY_STOCK_ENTITY_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_stock_entity_datetime`;
+CREATE TABLE `inventory_stock_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` decimal(20,6) DEFAULT NULL,
+  `website_id` mediumtext,
+  `position` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_stock_entity_decimal`;
+CREATE TABLE `inventory_stock_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `price` text,
+  `sku` text,
+  `state` tinyint(1) NOT NULL DEFAULT '1',
+  `level` varchar(64) NOT NULL,
+  `parent_id` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_STOCK_ENTITY_DECIMAL_PRICE` (`price`),
+  KEY `INVENTORY_STOCK_ENTITY_DECIMAL_STATE` (`state`),
+  KEY `INVENTORY_STOCK_ENTITY_DECIMAL_LEVEL` (`level`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_stock_label`;
+CREATE TABLE `inventory_stock_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` datetime DEFAULT NULL,
+  `updated_at` text,
+  `qty` tinyint(1) NOT NULL DEFAULT '1',
+  `is_active` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_STOCK_LABEL_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `inventory_stock_price`;
+CREATE TABLE `inventory_stock_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `code` text,
+  `type_id` varchar(64) NOT NULL,
+  `level` int(11) DEFAULT NULL,
+  `updated_at` text,
+  `sku` varchar(64) NOT NULL,
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `attribute_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `qty` varchar(255) DEFAULT NULL,
+  `email` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `position` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `INVENTORY_STOCK_PRICE_LEVEL` (`level`),
+  KEY `INVENTORY_STOCK_PRICE_UPDATED_AT` (`updated_at`),
+  KEY `INVENTORY_STOCK_PRICE_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `layout_entity_decimal`;
+CREATE TABLE `layout_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` mediumtext,
+  `updated_at` int(10) unsigned NOT NULL,
+  `qty` int(10) unsigned NOT NULL,
+  `created_at` datetime DEFAULT NULL,
+  `sort_order` int(10) unsigned NOT NULL,
+  `store_id` int(11) DEFAULT NULL,
+  `state` mediumtext,
+  `content` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `LAYOUT_ENTITY_DECIMAL_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `layout_label`;
+CREATE TABLE `layout_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` varchar(255) DEFAULT NULL,
+  `website_id` tinyint(1) NOT NULL DEFAULT '1',
+  `path` mediumtext,
+  `type_id` datetime DEFAULT NULL,
+  `updated_at` text,
+  `is_active` varchar(255) DEFAULT NULL,
+  `level` text,
+  `base_currency_code` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `LAYOUT_LABEL_WEBSITE_ID` (`website_id`),
+  KEY `LAYOUT_LABEL_PATH` (`path`),
+  KEY `LAYOUT_LABEL_TYPE_ID` (`type_id`),
+  KEY `LAYOUT_LABEL_IS_ACTIVE` (`is_active`),
+  KEY `LAYOUT_LABEL_LEVEL` (`level`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `layout_log`;
+CREATE TABLE `layout_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` varchar(255) DEFAULT NULL,
+  `code` varchar(255) DEFAULT NULL,
+  `updated_at` varchar(255) DEFAULT NULL,
+  `value` mediumtext,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `layout_option`;
+CREATE TABLE `layout_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` varchar(255) DEFAULT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `title` text,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `is_active` int(10) unsigned NOT NULL,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `email` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `LAYOUT_OPTION_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `layout_store`;
+CREATE TABLE `layout_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` int(10) unsigned NOT NULL,
+  `is_active` datetime DEFAULT NULL,
+  `level` tinyint(1) NOT NULL DEFAULT '1',
+  `email` text,
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `status` int(11) DEFAULT NULL,
+  `website_id` varchar(255) DEFAULT NULL,
+  `customer_id` varchar(255) DEFAULT NULL,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `LAYOUT_STORE_EMAIL` (`email`),
+  KEY `LAYOUT_STORE_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `mview_attribute`;
+CREATE TABLE `mview_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `updated_at` int(11) DEFAULT NULL,
+  `status` varchar(64) NOT NULL,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `MVIEW_ATTRIBUTE_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `mview_entity`;
+CREATE TABLE `mview_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` int(11) DEFAULT NULL,
+  `is_active` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` mediumtext,
+  `price` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `MVIEW_ENTITY_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `mview_link`;
+CREATE TABLE `mview_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` int(10) unsigned NOT NULL,
+  `store_id` varchar(64) NOT NULL,
+  `path` decimal(20,6) DEFAULT NULL,
+  `parent_id` varchar(255) DEFAULT NULL,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `position` mediumtext,
+  `is_active` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` mediumtext,
+  `code` decimal(20,6) DEFAULT NULL,
+  `price` tinyint(1) NOT NULL DEFAULT '1',
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `MVIEW_LINK_PATH` (`path`),
+  KEY `MVIEW_LINK_PARENT_ID` (`parent_id`),
+  KEY `MVIEW_LINK_WEBSITE_ID` (`website_id`),
+  KEY `MVIEW_LINK_POSITION` (`position`),
+  KEY `MVIEW_LINK_LEVEL` (`level`),
+  KEY `MVIEW_LINK_PRICE` (`price`),
+  CONSTRAINT `MVIEW_LINK_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `mview_status`;
+CREATE TABLE `mview_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` text,
+  `path` int(11) DEFAULT NULL,
+  `status` int(11) DEFAULT NULL,
+  `base_currency_code` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `MVIEW_STATUS_EMAIL` (`email`),
+  KEY `MVIEW_STATUS_PATH` (`path`),
+  KEY `MVIEW_STATUS_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `mview_tmp`;
+CREATE TABLE `mview_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` int(11) DEFAULT NULL,
+  `base_currency_code` tinyint(1) NOT NULL DEFAULT '1',
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `created_at` datetime DEFAULT NULL,
+  `parent_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `MVIEW_TMP_TYPE_ID` (`type_id`),
+  KEY `MVIEW_TMP_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `newsletter_entity_varchar`;
+CREATE TABLE `newsletter_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` text,
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `position` varchar(255) DEFAULT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `NEWSLETTER_ENTITY_VARCHAR_WEBSITE_ID` (`website_id`),
+  KEY `NEWSLETTER_ENTITY_VARCHAR_SORT_ORDER` (`sort_order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `newsletter_grid`;
+CREATE TABLE `newsletter_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `customer_id` tinyint(1) NOT NULL DEFAULT '1',
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `title` decimal(20,6) DEFAULT NULL,
+  `path` int(11) DEFAULT NULL,
+  `state` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `NEWSLETTER_GRID_LEVEL` (`level`),
+  KEY `NEWSLETTER_GRID_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `newsletter_index`;
+CREATE TABLE `newsletter_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `label` int(11) DEFAULT NULL,
+  `level` int(10) unsigned NOT NULL,
+  `state` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` decimal(20,6) DEFAULT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `updated_at` int(11) DEFAULT NULL,
+  `created_at` mediumtext,
+  `code` int(11) DEFAULT NULL,
+  `price` varchar(255) DEFAULT NULL,
+  `base_currency_code` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `NEWSLETTER_INDEX_LABEL` (`label`),
+  KEY `NEWSLETTER_INDEX_LEVEL` (`level`),
+  KEY `NEWSLETTER_INDEX_STATE` (`state`),
+  KEY `NEWSLETTER_INDEX_PARENT_ID` (`parent_id`),
+  KEY `NEWSLETTER_INDEX_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `newsletter_price`;
+CREATE TABLE `newsletter_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `increment_id` datetime DEFAULT NULL,
+  `created_at` int(10) unsigned NOT NULL,
+  `status` int(11) DEFAULT NULL,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` datetime DEFAULT NULL,
+  `base_currency_code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `grand_total` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `newsletter_tmp`;
+CREATE TABLE `newsletter_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` decimal(20,6) DEFAULT NULL,
+  `code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `oauth_entity_decimal`;
+CREATE TABLE `oauth_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` int(11) DEFAULT NULL,
+  `is_active` int(11) DEFAULT NULL,
+  `qty` datetime DEFAULT NULL,
+  `grand_total` text,
+  `position` datetime DEFAULT NULL,
+  `increment_id` varchar(64) NOT NULL,
+  `parent_id` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `OAUTH_ENTITY_DECIMAL_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `oauth_grid`;
+CREATE TABLE `oauth_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` int(10) unsigned NOT NULL,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `content` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `base_currency_code` int(11) DEFAULT NULL,
+  `is_active` int(11) DEFAULT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `code` varchar(255) DEFAULT NULL,
+  `level` int(10) unsigned NOT NULL,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `oauth_label`;
+CREATE TABLE `oauth_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` decimal(20,6) DEFAULT NULL,
+  `is_active` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `OAUTH_LABEL_IS_ACTIVE` (`is_active`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `oauth_log`;
+CREATE TABLE `oauth_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` varchar(64) NOT NULL,
+  `increment_id` decimal(20,6) DEFAULT NULL,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `position` varchar(64) NOT NULL,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `label` int(11) DEFAULT NULL,
+  `price` tinyint(1) NOT NULL DEFAULT '1',
+  `content` varchar(255) DEFAULT NULL,
+  `sku` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `OAUTH_LOG_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `oauth_price`;
+CREATE TABLE `oauth_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` tinyint(1) NOT NULL DEFAULT '1',
+  `email` datetime DEFAULT NULL,
+  `code` decimal(20,6) DEFAULT NULL,
+  `status` int(11) DEFAULT NULL,
+  `price` int(10) unsigned NOT NULL,
+  `created_at` text,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `state` mediumtext,
+  `updated_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `OAUTH_PRICE_TYPE_ID` (`type_id`),
+  KEY `OAUTH_PRICE_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `paypal_attribute`;
+CREATE TABLE `paypal_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` int(10) unsigned NOT NULL,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `paypal_entity`;
+CREATE TABLE `paypal_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` mediumtext,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `paypal_entity_datetime`;
+CREATE TABLE `paypal_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` decimal(20,6) DEFAULT NULL,
+  `status` datetime DEFAULT NULL,
+  `value` datetime DEFAULT NULL,
+  `position` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` varchar(64) NOT NULL,
+  `code` decimal(20,6) DEFAULT NULL,
+  `level` mediumtext,
+  `is_active` mediumtext,
+  `state` int(11) DEFAULT NULL,
+  `created_at` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `PAYPAL_ENTITY_DATETIME_STATUS` (`status`),
+  KEY `PAYPAL_ENTITY_DATETIME_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `PAYPAL_ENTITY_DATETIME_CODE` (`code`),
+  KEY `PAYPAL_ENTITY_DATETIME_LEVEL` (`level`),
+  KEY `PAYPAL_ENTITY_DATETIME_STATE` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `paypal_entity_text`;
+CREATE TABLE `paypal_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `attribute_id` text,
+  `price` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `PAYPAL_ENTITY_TEXT_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `paypal_option`;
+CREATE TABLE `paypal_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` text,
+  `type_id` text,
+  `store_id` text,
+  `state` int(10) unsigned NOT NULL,
+  `sort_order` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `label` varchar(64) NOT NULL,
+  `website_id` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `persistent_attribute`;
+CREATE TABLE `persistent_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` text,
+  `content` int(11) DEFAULT NULL,
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `PERSISTENT_ATTRIBUTE_CONTENT` (`content`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `persistent_entity_datetime`;
+CREATE TABLE `persistent_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` datetime DEFAULT NULL,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `type_id` mediumtext,
+  `qty` tinyint(1) NOT NULL DEFAULT '1',
+  `position` varchar(64) NOT NULL,
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `store_id` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `PERSISTENT_ENTITY_DATETIME_PATH` (`path`),
+  KEY `PERSISTENT_ENTITY_DATETIME_QTY` (`qty`),
+  KEY `PERSISTENT_ENTITY_DATETIME_POSITION` (`position`),
+  KEY `PERSISTENT_ENTITY_DATETIME_VALUE` (`value`),
+  KEY `PERSISTENT_ENTITY_DATETIME_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `persistent_entity_decimal`;
+CREATE TABLE `persistent_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `is_active` mediumtext,
+  `label` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `PERSISTENT_ENTITY_DECIMAL_STORE_ID` (`store_id`),
+  KEY `PERSISTENT_ENTITY_DECIMAL_IS_ACTIVE` (`is_active`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `persistent_item`;
+CREATE TABLE `persistent_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `label` mediumtext,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `status` varchar(64) NOT NULL,
+  `content` varchar(255) DEFAULT NULL,
+  `customer_id` int(10) unsigned NOT NULL,
+  `attribute_id` mediumtext,
+  `increment_id` datetime DEFAULT NULL,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sku` int(10) unsigned NOT NULL,
+  `updated_at` varchar(64) NOT NULL,
+  `grand_total` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `PERSISTENT_ITEM_LABEL` (`label`),
+  KEY `PERSISTENT_ITEM_STATUS` (`status`),
+  KEY `PERSISTENT_ITEM_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `persistent_link`;
+CREATE TABLE `persistent_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `title` tinyint(1) NOT NULL DEFAULT '1',
+  `email` varchar(64) NOT NULL,
+  `position` decimal(20,6) DEFAULT NULL,
+  `increment_id` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `PERSISTENT_LINK_TITLE` (`title`),
+  KEY `PERSISTENT_LINK_INCREMENT_ID` (`increment_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `quote_attribute`;
+CREATE TABLE `quote_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `QUOTE_ATTRIBUTE_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `quote_entity_decimal`;
+CREATE TABLE `quote_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `state` varchar(255) DEFAULT NULL,
+  `code` int(10) unsigned NOT NULL,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `price` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `QUOTE_ENTITY_DECIMAL_CODE` (`code`),
+  KEY `QUOTE_ENTITY_DECIMAL_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `quote_index`;
+CREATE TABLE `quote_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `price` datetime DEFAULT NULL,
+  `value` decimal(20,6) DEFAULT NULL,
+  `sku` mediumtext,
+  `email` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `QUOTE_INDEX_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `quote_link`;
+CREATE TABLE `quote_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `state` text,
+  `sku` text,
+  `type_id` text,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `parent_id` text,
+  `email` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `QUOTE_LINK_WEBSITE_ID` (`website_id`),
+  KEY `QUOTE_LINK_STATE` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `quote_tmp`;
+CREATE TABLE `quote_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `value` int(11) DEFAULT NULL,
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` varchar(64) NOT NULL,
+  `content` text,
+  `updated_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `QUOTE_TMP_CONTENT` (`content`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `rating_item`;
+CREATE TABLE `rating_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `label` decimal(20,6) DEFAULT NULL,
+  `attribute_id` text,
+  `code` int(10) unsigned NOT NULL,
+  `value` int(10) unsigned NOT NULL,
+  `increment_id` text,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` int(10) unsigned NOT NULL,
+  `state` varchar(64) NOT NULL,
+  `updated_at` varchar(64) NOT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `email` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `RATING_ITEM_INCREMENT_ID` (`increment_id`),
+  KEY `RATING_ITEM_WEBSITE_ID` (`website_id`),
+  KEY `RATING_ITEM_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `rating_link`;
+CREATE TABLE `rating_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` datetime DEFAULT NULL,
+  `increment_id` tinyint(1) NOT NULL DEFAULT '1',
+  `path` datetime DEFAULT NULL,
+  `level` decimal(20,6) DEFAULT NULL,
+  `customer_id` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `RATING_LINK_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `rating_log`;
+CREATE TABLE `rating_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` tinyint(1) NOT NULL DEFAULT '1',
+  `label` decimal(20,6) DEFAULT NULL,
+  `qty` varchar(255) DEFAULT NULL,
+  `email` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `RATING_LOG_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `rating_option`;
+CREATE TABLE `rating_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` text,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `website_id` datetime DEFAULT NULL,
+  `attribute_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `path` text,
+  `label` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `RATING_OPTION_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `rating_relation`;
+CREATE TABLE `rating_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` int(10) unsigned NOT NULL,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` varchar(255) DEFAULT NULL,
+  `is_active` decimal(20,6) DEFAULT NULL,
+  `website_id` tinyint(1) NOT NULL DEFAULT '1',
+  `code` decimal(20,6) DEFAULT NULL,
+  `path` text,
+  `parent_id` int(10) unsigned NOT NULL,
+  `value` int(11) DEFAULT NULL,
+  `created_at` datetime DEFAULT NULL,
+  `customer_id` int(10) unsigned NOT NULL,
+  `state` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `RATING_RELATION_QTY` (`qty`),
+  KEY `RATING_RELATION_WEBSITE_ID` (`website_id`),
+  KEY `RATING_RELATION_VALUE` (`value`),
+  KEY `RATING_RELATION_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `reporting_entity`;
+CREATE TABLE `reporting_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `increment_id` decimal(20,6) DEFAULT NULL,
+  `parent_id` datetime DEFAULT NULL,
+  `code` int(11) DEFAULT NULL,
+  `sku` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `REPORTING_ENTITY_PARENT_ID` (`parent_id`),
+  KEY `REPORTING_ENTITY_SKU` (`sku`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `reporting_entity_decimal`;
+CREATE TABLE `reporting_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` int(10) unsigned NOT NULL,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` decimal(20,6) DEFAULT NULL,
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `code` int(11) DEFAULT NULL,
+  `type_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` varchar(64) NOT NULL,
+  `attribute_id` tinyint(1) NOT NULL DEFAULT '1',
+  `increment_id` int(11) DEFAULT NULL,
+  `content` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `REPORTING_ENTITY_DECIMAL_LABEL` (`label`),
+  KEY `REPORTING_ENTITY_DECIMAL_INCREMENT_ID` (`increment_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `reporting_entity_int`;
+CREATE TABLE `reporting_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` mediumtext,
+  `increment_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `position` varchar(64) NOT NULL,
+  `website_id` varchar(255) DEFAULT NULL,
+  `title` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `reporting_index`;
+CREATE TABLE `reporting_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `price` decimal(20,6) DEFAULT NULL,
+  `email` tinyint(1) NOT NULL DEFAULT '1',
+  `state` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `reporting_tmp`;
+CREATE TABLE `reporting_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` mediumtext,
+  `created_at` int(11) DEFAULT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `updated_at` tinyint(1) NOT NULL DEFAULT '1',
+  `position` decimal(20,6) DEFAULT NULL,
+  `code` text,
+  `customer_id` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `REPORTING_TMP_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `REPORTING_TMP_INCREMENT_ID` (`increment_id`),
+  KEY `REPORTING_TMP_POSITION` (`position`),
+  KEY `REPORTING_TMP_CODE` (`code`),
+  KEY `REPORTING_TMP_CUSTOMER_ID` (`customer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `review_entity_varchar`;
+CREATE TABLE `review_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` mediumtext,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `label` datetime DEFAULT NULL,
+  `level` varchar(64) NOT NULL,
+  `customer_id` int(10) unsigned NOT NULL,
+  `website_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `email` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` int(10) unsigned NOT NULL,
+  `sort_order` int(11) DEFAULT NULL,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `REVIEW_ENTITY_VARCHAR_LABEL` (`label`),
+  KEY `REVIEW_ENTITY_VARCHAR_SORT_ORDER` (`sort_order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `review_grid`;
+CREATE TABLE `review_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` mediumtext,
+  `parent_id` int(11) DEFAULT NULL,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `level` varchar(64) NOT NULL,
+  `path` int(10) unsigned NOT NULL,
+  `position` varchar(64) NOT NULL,
+  `code` varchar(64) NOT NULL,
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `label` decimal(20,6) DEFAULT NULL,
+  `state` mediumtext,
+  `grand_total` decimal(20,6) DEFAULT NULL,
+  `is_active` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `REVIEW_GRID_SKU` (`sku`),
+  KEY `REVIEW_GRID_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `review_item`;
+CREATE TABLE `review_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` text,
+  `base_currency_code` datetime DEFAULT NULL,
+  `grand_total` datetime DEFAULT NULL,
+  `attribute_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `REVIEW_ITEM_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `review_status`;
+CREATE TABLE `review_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `price` decimal(20,6) DEFAULT NULL,
+  `level` tinyint(1) NOT NULL DEFAULT '1',
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `position` tinyint(1) NOT NULL DEFAULT '1',
+  `is_active` text,
+  `website_id` text,
+  `code` int(11) DEFAULT NULL,
+  `parent_id` decimal(20,6) DEFAULT NULL,
+  `created_at` varchar(64) NOT NULL,
+  `type_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `REVIEW_STATUS_LEVEL` (`level`),
+  KEY `REVIEW_STATUS_SORT_ORDER` (`sort_order`),
+  KEY `REVIEW_STATUS_STATE` (`state`),
+  KEY `REVIEW_STATUS_POSITION` (`position`),
+  KEY `REVIEW_STATUS_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `review_website`;
+CREATE TABLE `review_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `code` varchar(64) NOT NULL,
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `value` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sort_order` int(11) DEFAULT NULL,
+  `status` datetime DEFAULT NULL,
+  `updated_at` datetime DEFAULT NULL,
+  `store_id` int(11) DEFAULT NULL,
+  `position` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_creditmemo_attribute`;
+CREATE TABLE `sales_creditmemo_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `parent_id` datetime DEFAULT NULL,
+  `type_id` decimal(20,6) DEFAULT NULL,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `title` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_CREDITMEMO_ATTRIBUTE_WEBSITE_ID` (`website_id`),
+  KEY `SALES_CREDITMEMO_ATTRIBUTE_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_creditmemo_grid`;
+CREATE TABLE `sales_creditmemo_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `value` mediumtext,
+  `price` text,
+  `state` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_CREDITMEMO_GRID_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_creditmemo_index`;
+CREATE TABLE `sales_creditmemo_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` varchar(64) NOT NULL,
+  `state` decimal(20,6) DEFAULT NULL,
+  `website_id` mediumtext,
+  `grand_total` mediumtext,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `position` int(10) unsigned NOT NULL,
+  `qty` int(11) DEFAULT NULL,
+  `is_active` int(10) unsigned NOT NULL,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` text,
+  `sku` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_CREDITMEMO_INDEX_TYPE_ID` (`type_id`),
+  KEY `SALES_CREDITMEMO_INDEX_STATE` (`state`),
+  KEY `SALES_CREDITMEMO_INDEX_QTY` (`qty`),
+  KEY `SALES_CREDITMEMO_INDEX_IS_ACTIVE` (`is_active`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_creditmemo_price`;
+CREATE TABLE `sales_creditmemo_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` varchar(255) DEFAULT NULL,
+  `qty` tinyint(1) NOT NULL DEFAULT '1',
+  `level` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_creditmemo_status`;
+CREATE TABLE `sales_creditmemo_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` text,
+  `level` varchar(255) DEFAULT NULL,
+  `email` varchar(255) DEFAULT NULL,
+  `value` datetime DEFAULT NULL,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `type_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_invoice_attribute`;
+CREATE TABLE `sales_invoice_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` datetime DEFAULT NULL,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `path` varchar(255) DEFAULT NULL,
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `website_id` varchar(64) NOT NULL,
+  `customer_id` varchar(64) NOT NULL,
+  `sort_order` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `state` int(11) DEFAULT NULL,
+  `content` text,
+  `code` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_INVOICE_ATTRIBUTE_POSITION` (`position`),
+  KEY `SALES_INVOICE_ATTRIBUTE_CODE` (`code`),
+  KEY `SALES_INVOICE_ATTRIBUTE_SKU` (`sku`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_invoice_grid`;
+CREATE TABLE `sales_invoice_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `label` int(11) DEFAULT NULL,
+  `updated_at` int(10) unsigned NOT NULL,
+  `is_active` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_INVOICE_GRID_IS_ACTIVE` (`is_active`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_invoice_price`;
+CREATE TABLE `sales_invoice_price` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` decimal(20,6) DEFAULT NULL,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `qty` varchar(64) NOT NULL,
+  `website_id` text,
+  `path` int(11) DEFAULT NULL,
+  `code` datetime DEFAULT NULL,
+  `updated_at` decimal(20,6) DEFAULT NULL,
+  `label` decimal(20,6) DEFAULT NULL,
+  `increment_id` varchar(255) DEFAULT NULL,
+  `store_id` varchar(64) NOT NULL,
+  `level` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_INVOICE_PRICE_EMAIL` (`email`),
+  KEY `SALES_INVOICE_PRICE_QTY` (`qty`),
+  KEY `SALES_INVOICE_PRICE_CODE` (`code`),
+  KEY `SALES_INVOICE_PRICE_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_invoice_store`;
+CREATE TABLE `sales_invoice_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` varchar(64) NOT NULL,
+  `email` tinyint(1) NOT NULL DEFAULT '1',
+  `is_active` mediumtext,
+  `type_id` tinyint(1) NOT NULL DEFAULT '1',
+  `level` decimal(20,6) DEFAULT NULL,
+  `code` varchar(64) NOT NULL,
+  `value` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_INVOICE_STORE_IS_ACTIVE` (`is_active`),
+  KEY `SALES_INVOICE_STORE_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_invoice_website`;
+CREATE TABLE `sales_invoice_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sku` int(10) unsigned NOT NULL,
+  `level` text,
+  `attribute_id` int(11) DEFAULT NULL,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `parent_id` int(11) DEFAULT NULL,
+  `type_id` int(11) DEFAULT NULL,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `base_currency_code` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_INVOICE_WEBSITE_SKU` (`sku`),
+  KEY `SALES_INVOICE_WEBSITE_STORE_ID` (`store_id`),
+  KEY `SALES_INVOICE_WEBSITE_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_order_attribute`;
+CREATE TABLE `sales_order_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` int(10) unsigned NOT NULL,
+  `grand_total` int(10) unsigned NOT NULL,
+  `qty` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_ORDER_ATTRIBUTE_CONTENT` (`content`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_order_entity_datetime`;
+CREATE TABLE `sales_order_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` mediumtext,
+  `increment_id` decimal(20,6) DEFAULT NULL,
+  `sku` int(10) unsigned NOT NULL,
+  `path` datetime DEFAULT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `price` mediumtext,
+  `updated_at` mediumtext,
+  `type_id` tinyint(1) NOT NULL DEFAULT '1',
+  `title` int(10) unsigned NOT NULL,
+  `grand_total` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_ORDER_ENTITY_DATETIME_STORE_ID` (`store_id`),
+  KEY `SALES_ORDER_ENTITY_DATETIME_CREATED_AT` (`created_at`),
+  KEY `SALES_ORDER_ENTITY_DATETIME_SKU` (`sku`),
+  KEY `SALES_ORDER_ENTITY_DATETIME_PATH` (`path`),
+  KEY `SALES_ORDER_ENTITY_DATETIME_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_order_entity_decimal`;
+CREATE TABLE `sales_order_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` int(10) unsigned NOT NULL,
+  `parent_id` text,
+  `label` int(11) DEFAULT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` varchar(64) NOT NULL,
+  `attribute_id` decimal(20,6) DEFAULT NULL,
+  `level` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_ORDER_ENTITY_DECIMAL_PARENT_ID` (`parent_id`),
+  KEY `SALES_ORDER_ENTITY_DECIMAL_LABEL` (`label`),
+  KEY `SALES_ORDER_ENTITY_DECIMAL_SORT_ORDER` (`sort_order`),
+  KEY `SALES_ORDER_ENTITY_DECIMAL_LEVEL` (`level`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_order_index`;
+CREATE TABLE `sales_order_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` tinyint(1) NOT NULL DEFAULT '1',
+  `parent_id` varchar(64) NOT NULL,
+  `state` text,
+  `grand_total` decimal(20,6) DEFAULT NULL,
+  `position` varchar(64) NOT NULL,
+  `qty` varchar(64) NOT NULL,
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `sku` text,
+  `attribute_id` int(11) DEFAULT NULL,
+  `path` decimal(20,6) DEFAULT NULL,
+  `created_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_ORDER_INDEX_STATUS` (`status`),
+  KEY `SALES_ORDER_INDEX_POSITION` (`position`),
+  KEY `SALES_ORDER_INDEX_QTY` (`qty`),
+  KEY `SALES_ORDER_INDEX_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `SALES_ORDER_INDEX_CREATED_AT` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_order_log`;
+CREATE TABLE `sales_order_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `website_id` decimal(20,6) DEFAULT NULL,
+  `increment_id` int(11) DEFAULT NULL,
+  `code` tinyint(1) NOT NULL DEFAULT '1',
+  `status` text,
+  `state` datetime DEFAULT NULL,
+  `label` tinyint(1) NOT NULL DEFAULT '1',
+  `email` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_ORDER_LOG_CUSTOMER_ID` (`customer_id`),
+  KEY `SALES_ORDER_LOG_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_shipment_entity`;
+CREATE TABLE `sales_shipment_entity` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `level` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `customer_id` int(10) unsigned NOT NULL,
+  `state` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` tinyint(1) NOT NULL DEFAULT '1',
+  `email` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_SHIPMENT_ENTITY_LEVEL` (`level`),
+  KEY `SALES_SHIPMENT_ENTITY_STATE` (`state`),
+  KEY `SALES_SHIPMENT_ENTITY_EMAIL` (`email`),
+  CONSTRAINT `SALES_SHIPMENT_ENTITY_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_shipment_entity_int`;
+CREATE TABLE `sales_shipment_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` varchar(64) NOT NULL,
+  `status` decimal(20,6) DEFAULT NULL,
+  `price` varchar(64) NOT NULL,
+  `updated_at` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_shipment_grid`;
+CREATE TABLE `sales_shipment_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` datetime DEFAULT NULL,
+  `base_currency_code` varchar(64) NOT NULL,
+  `grand_total` tinyint(1) NOT NULL DEFAULT '1',
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `store_id` datetime DEFAULT NULL,
+  `value` varchar(64) NOT NULL,
+  `label` text,
+  `price` varchar(255) DEFAULT NULL,
+  `level` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_SHIPMENT_GRID_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `SALES_SHIPMENT_GRID_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_shipment_item`;
+CREATE TABLE `sales_shipment_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `path` text,
+  `value` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sales_shipment_status`;
+CREATE TABLE `sales_shipment_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` datetime DEFAULT NULL,
+  `email` varchar(255) DEFAULT NULL,
+  `sku` text,
+  `price` varchar(255) DEFAULT NULL,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` varchar(64) NOT NULL,
+  `type_id` datetime DEFAULT NULL,
+  `grand_total` int(11) DEFAULT NULL,
+  `path` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALES_SHIPMENT_STATUS_EMAIL` (`email`),
+  KEY `SALES_SHIPMENT_STATUS_SKU` (`sku`),
+  KEY `SALES_SHIPMENT_STATUS_LEVEL` (`level`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `salesrule_entity_text`;
+CREATE TABLE `salesrule_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` varchar(64) NOT NULL,
+  `sku` decimal(20,6) DEFAULT NULL,
+  `is_active` decimal(20,6) DEFAULT NULL,
+  `email` text,
+  `content` decimal(20,6) DEFAULT NULL,
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` text,
+  `qty` text,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `value` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALESRULE_ENTITY_TEXT_SKU` (`sku`),
+  KEY `SALESRULE_ENTITY_TEXT_IS_ACTIVE` (`is_active`),
+  KEY `SALESRULE_ENTITY_TEXT_EMAIL` (`email`),
+  KEY `SALESRULE_ENTITY_TEXT_PARENT_ID` (`parent_id`),
+  KEY `SALESRULE_ENTITY_TEXT_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `salesrule_entity_varchar`;
+CREATE TABLE `salesrule_entity_varchar` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `label` datetime DEFAULT NULL,
+  `updated_at` varchar(64) NOT NULL,
+  `level` datetime DEFAULT NULL,
+  `status` int(10) unsigned NOT NULL,
+  `price` varchar(64) NOT NULL,
+  `base_currency_code` text,
+  `created_at` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALESRULE_ENTITY_VARCHAR_UPDATED_AT` (`updated_at`),
+  KEY `SALESRULE_ENTITY_VARCHAR_LEVEL` (`level`),
+  KEY `SALESRULE_ENTITY_VARCHAR_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `SALESRULE_ENTITY_VARCHAR_CREATED_AT` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `salesrule_grid`;
+CREATE TABLE `salesrule_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` varchar(64) NOT NULL,
+  `type_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` varchar(255) DEFAULT NULL,
+  `website_id` text,
+  `status` varchar(64) NOT NULL,
+  `sort_order` varchar(255) DEFAULT NULL,
+  `state` decimal(20,6) DEFAULT NULL,
+  `grand_total` datetime DEFAULT NULL,
+  `title` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALESRULE_GRID_TYPE_ID` (`type_id`),
+  KEY `SALESRULE_GRID_WEBSITE_ID` (`website_id`),
+  KEY `SALESRULE_GRID_STATE` (`state`),
+  KEY `SALESRULE_GRID_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `salesrule_item`;
+CREATE TABLE `salesrule_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` int(11) DEFAULT NULL,
+  `title` datetime DEFAULT NULL,
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `is_active` mediumtext,
+  `grand_total` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attribute_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `increment_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALESRULE_ITEM_CREATED_AT` (`created_at`),
+  KEY `SALESRULE_ITEM_SKU` (`sku`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `salesrule_tmp`;
+CREATE TABLE `salesrule_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `value` text,
+  `sort_order` mediumtext,
+  `attribute_id` mediumtext,
+  `email` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SALESRULE_TMP_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `search_grid`;
+CREATE TABLE `search_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `code` mediumtext,
+  `base_currency_code` decimal(20,6) DEFAULT NULL,
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `parent_id` int(10) unsigned NOT NULL,
+  `title` text,
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `attribute_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SEARCH_GRID_TITLE` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `search_index`;
+CREATE TABLE `search_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `status` tinyint(1) NOT NULL DEFAULT '1',
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `customer_id` tinyint(1) NOT NULL DEFAULT '1',
+  `website_id` varchar(255) DEFAULT NULL,
+  `path` varchar(64) NOT NULL,
+  `store_id` text,
+  `attribute_id` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `SEARCH_INDEX_STATE` (`state`),
+  CONSTRAINT `SEARCH_INDEX_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `search_log`;
+CREATE TABLE `search_log` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` text,
+  `path` varchar(255) DEFAULT NULL,
+  `level` int(10) unsigned NOT NULL,
+  `position` int(11) DEFAULT NULL,
+  `is_active` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `store_id` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SEARCH_LOG_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `search_status`;
+CREATE TABLE `search_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `customer_id` text,
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `price` varchar(255) DEFAULT NULL,
+  `grand_total` varchar(255) DEFAULT NULL,
+  `status` text,
+  `is_active` tinyint(1) NOT NULL DEFAULT '1',
+  `level` varchar(64) NOT NULL,
+  `qty` decimal(20,6) DEFAULT NULL,
+  `website_id` text,
+  `label` varchar(64) NOT NULL,
+  `type_id` mediumtext,
+  `base_currency_code` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SEARCH_STATUS_CUSTOMER_ID` (`customer_id`),
+  KEY `SEARCH_STATUS_PRICE` (`price`),
+  KEY `SEARCH_STATUS_STATUS` (`status`),
+  KEY `SEARCH_STATUS_IS_ACTIVE` (`is_active`),
+  KEY `SEARCH_STATUS_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `search_store`;
+CREATE TABLE `search_store` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `store_id` varchar(255) DEFAULT NULL,
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` mediumtext,
+  `type_id` varchar(255) DEFAULT NULL,
+  `website_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attribute_id` int(10) unsigned NOT NULL,
+  `state` int(11) DEFAULT NULL,
+  `path` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `SEARCH_STORE_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `SEARCH_STORE_ATTRIBUTE_ID` (`attribute_id`),
+  KEY `SEARCH_STORE_STATE` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sitemap_attribute`;
+CREATE TABLE `sitemap_attribute` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `title` text,
+  `grand_total` varchar(64) NOT NULL,
+  `customer_id` tinyint(1) NOT NULL DEFAULT '1',
+  `sku` tinyint(1) NOT NULL DEFAULT '1',
+  `increment_id` varchar(64) NOT NULL,
+  `base_currency_code` datetime DEFAULT NULL,
+  `type_id` int(11) DEFAULT NULL,
+  `created_at` text,
+  `updated_at` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `SITEMAP_ATTRIBUTE_TITLE` (`title`),
+  KEY `SITEMAP_ATTRIBUTE_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `SITEMAP_ATTRIBUTE_CREATED_AT` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sitemap_entity_int`;
+CREATE TABLE `sitemap_entity_int` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` int(11) DEFAULT NULL,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `content` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `level` datetime DEFAULT NULL,
+  `type_id` varchar(64) NOT NULL,
+  `parent_id` mediumtext,
+  `attribute_id` mediumtext,
+  `sort_order` varchar(64) NOT NULL,
+  `status` int(11) DEFAULT NULL,
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`entity_id`),
+  KEY `SITEMAP_ENTITY_INT_GRAND_TOTAL` (`grand_total`),
+  KEY `SITEMAP_ENTITY_INT_LEVEL` (`level`),
+  KEY `SITEMAP_ENTITY_INT_TYPE_ID` (`type_id`),
+  KEY `SITEMAP_ENTITY_INT_STATUS` (`status`),
+  KEY `SITEMAP_ENTITY_INT_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sitemap_entity_text`;
+CREATE TABLE `sitemap_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `grand_total` mediumtext,
+  `store_id` mediumtext,
+  `qty` datetime DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SITEMAP_ENTITY_TEXT_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sitemap_index`;
+CREATE TABLE `sitemap_index` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` decimal(20,6) DEFAULT NULL,
+  `qty` varchar(255) DEFAULT NULL,
+  `code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` text,
+  `path` varchar(255) DEFAULT NULL,
+  `status` decimal(20,6) DEFAULT NULL,
+  `sku` text,
+  `updated_at` varchar(255) DEFAULT NULL,
+  `customer_id` varchar(64) NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `SITEMAP_INDEX_QTY` (`qty`),
+  KEY `SITEMAP_INDEX_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `sitemap_relation`;
+CREATE TABLE `sitemap_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `is_active` varchar(64) NOT NULL,
+  `updated_at` varchar(255) DEFAULT NULL,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `SITEMAP_RELATION_UPDATED_AT` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `store_entity_datetime`;
+CREATE TABLE `store_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `label` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `sku` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status` varchar(64) NOT NULL,
+  `title` varchar(64) NOT NULL,
+  `state` int(10) unsigned NOT NULL,
+  `level` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `STORE_ENTITY_DATETIME_SKU` (`sku`),
+  KEY `STORE_ENTITY_DATETIME_STATUS` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `store_label`;
+CREATE TABLE `store_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `email` mediumtext,
+  `code` mediumtext,
+  `parent_id` varchar(64) NOT NULL,
+  `type_id` text,
+  `value` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `STORE_LABEL_PARENT_ID` (`parent_id`),
+  KEY `STORE_LABEL_TYPE_ID` (`type_id`),
+  KEY `STORE_LABEL_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `store_link`;
+CREATE TABLE `store_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `qty` varchar(255) DEFAULT NULL,
+  `is_active` tinyint(1) NOT NULL DEFAULT '1',
+  `sort_order` varchar(64) NOT NULL,
+  `email` decimal(20,6) DEFAULT NULL,
+  `status` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `customer_id` varchar(255) DEFAULT NULL,
+  `path` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` datetime DEFAULT NULL,
+  `label` mediumtext,
+  `title` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` int(11) DEFAULT NULL,
+  `created_at` mediumtext,
+  PRIMARY KEY (`entity_id`),
+  KEY `STORE_LINK_IS_ACTIVE` (`is_active`),
+  KEY `STORE_LINK_PATH` (`path`),
+  KEY `STORE_LINK_TITLE` (`title`),
+  KEY `STORE_LINK_GRAND_TOTAL` (`grand_total`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `store_option`;
+CREATE TABLE `store_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `qty` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `increment_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` int(10) unsigned NOT NULL,
+  `base_currency_code` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `label` varchar(255) DEFAULT NULL,
+  `customer_id` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `STORE_OPTION_LABEL` (`label`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `store_status`;
+CREATE TABLE `store_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `position` varchar(64) NOT NULL,
+  `website_id` int(10) unsigned NOT NULL,
+  `code` varchar(64) NOT NULL,
+  `sort_order` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` decimal(20,6) DEFAULT NULL,
+  `value` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `STORE_STATUS_POSITION` (`position`),
+  KEY `STORE_STATUS_WEBSITE_ID` (`website_id`),
+  KEY `STORE_STATUS_CODE` (`code`),
+  KEY `STORE_STATUS_SORT_ORDER` (`sort_order`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `tax_entity_decimal`;
+CREATE TABLE `tax_entity_decimal` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `content` tinyint(1) NOT NULL DEFAULT '1',
+  `email` tinyint(1) NOT NULL DEFAULT '1',
+  `value` tinyint(1) NOT NULL DEFAULT '1',
+  PRIMARY KEY (`entity_id`),
+  KEY `TAX_ENTITY_DECIMAL_CONTENT` (`content`),
+  KEY `TAX_ENTITY_DECIMAL_VALUE` (`value`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `tax_grid`;
+CREATE TABLE `tax_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `store_id` text,
+  `price` int(11) DEFAULT NULL,
+  `customer_id` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TAX_GRID_PRICE` (`price`),
+  CONSTRAINT `TAX_GRID_STORE_ID_STORE_STORE_ID` FOREIGN KEY (`store_id`) REFERENCES `store` (`store_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `tax_relation`;
+CREATE TABLE `tax_relation` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `type_id` int(10) unsigned NOT NULL,
+  `title` datetime DEFAULT NULL,
+  `base_currency_code` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` text,
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `email` int(11) DEFAULT NULL,
+  `is_active` decimal(20,6) DEFAULT NULL,
+  `position` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TAX_RELATION_TITLE` (`title`),
+  KEY `TAX_RELATION_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `TAX_RELATION_POSITION` (`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `tax_status`;
+CREATE TABLE `tax_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `website_id` varchar(255) DEFAULT NULL,
+  `parent_id` decimal(20,6) DEFAULT NULL,
+  `store_id` decimal(20,6) DEFAULT NULL,
+  `customer_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `code` text,
+  `path` varchar(255) DEFAULT NULL,
+  `position` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TAX_STATUS_WEBSITE_ID` (`website_id`),
+  KEY `TAX_STATUS_PARENT_ID` (`parent_id`),
+  KEY `TAX_STATUS_CODE` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `tax_tmp`;
+CREATE TABLE `tax_tmp` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `path` varchar(255) DEFAULT NULL,
+  `status` int(11) DEFAULT NULL,
+  `type_id` int(11) DEFAULT NULL,
+  `store_id` int(10) unsigned NOT NULL,
+  `updated_at` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TAX_TMP_TYPE_ID` (`type_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `theme_entity_datetime`;
+CREATE TABLE `theme_entity_datetime` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `is_active` varchar(64) NOT NULL,
+  `position` decimal(20,6) DEFAULT NULL,
+  `email` mediumtext,
+  `status` decimal(20,6) DEFAULT NULL,
+  `increment_id` varchar(64) NOT NULL,
+  `value` tinyint(1) NOT NULL DEFAULT '1',
+  `type_id` text,
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `qty` int(11) DEFAULT NULL,
+  `content` datetime DEFAULT NULL,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `state` int(10) unsigned NOT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `THEME_ENTITY_DATETIME_STATUS` (`status`),
+  KEY `THEME_ENTITY_DATETIME_VALUE` (`value`),
+  KEY `THEME_ENTITY_DATETIME_QTY` (`qty`),
+  KEY `THEME_ENTITY_DATETIME_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `theme_entity_text`;
+CREATE TABLE `theme_entity_text` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sort_order` text,
+  `increment_id` int(11) DEFAULT NULL,
+  `customer_id` varchar(64) NOT NULL,
+  `path` int(10) unsigned NOT NULL,
+  `parent_id` varchar(64) NOT NULL,
+  `price` datetime DEFAULT NULL,
+  `qty` varchar(255) DEFAULT NULL,
+  `content` decimal(20,6) DEFAULT NULL,
+  `label` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `value` varchar(255) DEFAULT NULL,
+  `store_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `status` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `THEME_ENTITY_TEXT_SORT_ORDER` (`sort_order`),
+  KEY `THEME_ENTITY_TEXT_PARENT_ID` (`parent_id`),
+  KEY `THEME_ENTITY_TEXT_CONTENT` (`content`),
+  KEY `THEME_ENTITY_TEXT_LABEL` (`label`),
+  KEY `THEME_ENTITY_TEXT_STORE_ID` (`store_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `theme_grid`;
+CREATE TABLE `theme_grid` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `base_currency_code` varchar(64) NOT NULL,
+  `label` varchar(255) DEFAULT NULL,
+  `level` decimal(20,6) DEFAULT NULL,
+  `email` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `increment_id` varchar(64) NOT NULL,
+  `type_id` datetime DEFAULT NULL,
+  `store_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `position` int(11) DEFAULT NULL,
+  `price` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `sort_order` text,
+  PRIMARY KEY (`entity_id`),
+  KEY `THEME_GRID_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `THEME_GRID_LABEL` (`label`),
+  KEY `THEME_GRID_LEVEL` (`level`),
+  KEY `THEME_GRID_EMAIL` (`email`),
+  KEY `THEME_GRID_TYPE_ID` (`type_id`),
+  KEY `THEME_GRID_STORE_ID` (`store_id`),
+  KEY `THEME_GRID_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `theme_option`;
+CREATE TABLE `theme_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `sort_order` tinyint(1) NOT NULL DEFAULT '1',
+  `title` varchar(255) DEFAULT NULL,
+  `path` decimal(20,6) DEFAULT NULL,
+  `qty` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `THEME_OPTION_PATH` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `theme_status`;
+CREATE TABLE `theme_status` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` varchar(64) NOT NULL,
+  `code` int(10) unsigned NOT NULL,
+  `price` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `level` varchar(255) DEFAULT NULL,
+  `website_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`),
+  KEY `THEME_STATUS_CODE` (`code`),
+  KEY `THEME_STATUS_PRICE` (`price`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `translation_item`;
+CREATE TABLE `translation_item` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `price` int(11) DEFAULT NULL,
+  `content` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `updated_at` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `store_id` tinyint(1) NOT NULL DEFAULT '1',
+  `is_active` tinyint(1) NOT NULL DEFAULT '1',
+  `position` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `grand_total` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type_id` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `path` text,
+  `parent_id` smallint(5) unsigned NOT NULL DEFAULT '0',
+  `email` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TRANSLATION_ITEM_POSITION` (`position`),
+  KEY `TRANSLATION_ITEM_EMAIL` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `translation_label`;
+CREATE TABLE `translation_label` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `level` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `value` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `store_id` text,
+  `qty` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TRANSLATION_LABEL_LEVEL` (`level`),
+  KEY `TRANSLATION_LABEL_QTY` (`qty`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `translation_link`;
+CREATE TABLE `translation_link` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `status` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `parent_id` datetime DEFAULT NULL,
+  `state` smallint(5) unsigned NOT NULL DEFAULT '0',
+  PRIMARY KEY (`entity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `translation_option`;
+CREATE TABLE `translation_option` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `base_currency_code` varchar(255) DEFAULT NULL,
+  `qty` varchar(64) NOT NULL,
+  `status` mediumtext,
+  `code` text,
+  `parent_id` text,
+  `type_id` tinyint(1) NOT NULL DEFAULT '1',
+  `position` decimal(20,6) DEFAULT NULL,
+  `content` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `label` text,
+  `sku` int(11) DEFAULT NULL,
+  `is_active` int(11) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TRANSLATION_OPTION_BASE_CURRENCY_CODE` (`base_currency_code`),
+  KEY `TRANSLATION_OPTION_TYPE_ID` (`type_id`),
+  KEY `TRANSLATION_OPTION_CONTENT` (`content`),
+  KEY `TRANSLATION_OPTION_LABEL` (`label`),
+  KEY `TRANSLATION_OPTION_SKU` (`sku`),
+  KEY `TRANSLATION_OPTION_IS_ACTIVE` (`is_active`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+DROP TABLE IF EXISTS `translation_website`;
+CREATE TABLE `translation_website` (
+  `entity_id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `attribute_id` varchar(255) DEFAULT NULL,
+  `state` tinyint(1) NOT NULL DEFAULT '1',
+  `price` decimal(20,6) DEFAULT NULL,
+  `code` text,
+  `content` text,
+  `status` int(10) unsigned NOT NULL,
+  `level` tinyint(1) NOT NULL DEFAULT '1',
+  `sort_order` varchar(64) NOT NULL,
+  `value` int(11) DEFAULT NULL,
+  `base_currency_code` datetime DEFAULT NULL,
+  `updated_at` decimal(20,6) DEFAULT NULL,
+  PRIMARY KEY (`entity_id`),
+  KEY `TRANSLATION_WEBSITE_STATUS` (`status`),
+  KEY `TRANSLATION_WEBSITE_BASE_CURRENCY_CODE` (`base_currency_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
@@ -0,0 +1,29 @@
+--
+-- Schema written by hand for the next release
+--
+
+CREATE TABLE public.accounts (
+    id serial PRIMARY KEY,
+    email character varying(320) NOT NULL UNIQUE,
+    display_name character varying(100),
+    created_at timestamp without time zone DEFAULT now() NOT NULL,
+    updated_at timestamp without time zone DEFAULT now() NOT NULL
+);
+
+CREATE TABLE public.invoices (
+    id serial PRIMARY KEY,
+    account_id integer NOT NULL REFERENCES public.accounts(id) ON DELETE CASCADE,
+    amount numeric(12,2) NOT NULL,
+    currency character(3) DEFAULT 'EUR'::bpchar NOT NULL,
+    status character varying(20) DEFAULT 'draft'::character varying NOT NULL,
+    issued_at timestamp with time zone
+);
+
+CREATE INDEX invoices_account_id_idx ON public.invoices USING btree (account_id);
+
+CREATE TABLE public.payments (
+    id bigserial PRIMARY KEY,
+    invoice_id integer NOT NULL,
+    paid_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT payments_invoice_id_fkey FOREIGN KEY (invoice_id) REFERENCES public.invoices(id)
+);
@@ -0,0 +1,25 @@
+-- MySQL dump 10.13  Distrib 8.0.36, for Linux (x86_64)
+--
+-- Host: localhost    Database: places
+
+DROP TABLE IF EXISTS `stores`;
+CREATE TABLE `stores` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `description` text,
+  `location` point NOT NULL /*!80003 SRID 4326 */,
+  `delivery_area` polygon NOT NULL /*!80003 SRID 4326 */,
+  PRIMARY KEY (`id`),
+  SPATIAL KEY `idx_location` (`location`),
+  SPATIAL KEY `sp_delivery_area` (`delivery_area`),
+  FULLTEXT KEY `ft_name_description` (`name`,`description`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+DROP TABLE IF EXISTS `regions`;
+CREATE TABLE `regions` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `boundary` multipolygon NOT NULL /*!80003 SRID 4326 */,
+  PRIMARY KEY (`id`),
+  SPATIAL KEY `sp_boundary` (`boundary`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS "users" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "email" TEXT NOT NULL UNIQUE,
+  "name" TEXT NOT NULL DEFAULT '',
+  "created_at" TEXT DEFAULT CURRENT_TIMESTAMP,
+  "avatar" BLOB
+);
+CREATE TABLE sqlite_sequence(name,seq);
+CREATE TABLE posts (
+  id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+  title TEXT NOT NULL,
+  body TEXT,
+  score INTEGER DEFAULT 0,
+  PRIMARY KEY (id)
+);
+CREATE INDEX idx_posts_user_id ON posts (user_id);
+CREATE TABLE [settings] (
+  [key] TEXT PRIMARY KEY,
+  [value]
+) WITHOUT ROWID;
+CREATE TABLE comments (
+  id INTEGER PRIMARY KEY,
+  post_id INTEGER NOT NULL,
+  body TEXT NOT NULL,
+  FOREIGN KEY (post_id) REFERENCES posts (id)
+);
+CREATE INDEX idx_comments_post ON comments (post_id);
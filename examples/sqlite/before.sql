@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "users" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "email" TEXT NOT NULL UNIQUE,
+  "name" TEXT,
+  "created_at" TEXT DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE sqlite_sequence(name,seq);
+CREATE TABLE posts (
+  id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  title TEXT NOT NULL,
+  body TEXT,
+  score REAL DEFAULT 0,
+  PRIMARY KEY (id)
+);
+CREATE INDEX idx_posts_user ON posts (user_id);
+CREATE TABLE [settings] (
+  [key] TEXT PRIMARY KEY,
+  [value]
+) WITHOUT ROWID;
+CREATE TABLE sessions (
+  token TEXT PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  expires_at INTEGER NOT NULL
+);
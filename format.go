@@ -0,0 +1,226 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"strings"
+)
+
+//go:embed templates/report.html
+var reportHTML string
+
+var reportTemplate = template.Must(template.New("report").Parse(reportHTML))
+
+type jsonReport struct {
+	A       string                   `json:"a"`
+	B       string                   `json:"b"`
+	Diffs   []Diff                   `json:"diffs"`
+	Summary map[string]int           `json:"summary"`
+	Lint    map[string][]LintWarning `json:"lint,omitempty"`
+}
+
+func writeJSON(w io.Writer, diffs []Diff, aFileName string, bFileName string, lint map[string][]LintWarning) error {
+
+	report := jsonReport{
+		A:       aFileName,
+		B:       bFileName,
+		Diffs:   diffs,
+		Summary: summarise(diffs),
+	}
+	if report.Diffs == nil {
+		report.Diffs = make([]Diff, 0)
+	}
+
+	for fileName, warnings := range lint {
+		if len(warnings) == 0 {
+			continue
+		}
+		if report.Lint == nil {
+			report.Lint = make(map[string][]LintWarning)
+		}
+		report.Lint[fileName] = warnings
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// writeCSV writes one row per diff after a type,severity,target,a,b header row.
+func writeCSV(w io.Writer, diffs []Diff) error {
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"type", "severity", "target", "a", "b"}); err != nil {
+		return err
+	}
+	for _, d := range diffs {
+		if err := cw.Write([]string{d.Type, d.Severity, d.Target, d.A, d.B}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+type diffSection struct {
+	Type  string
+	Class string
+	Diffs []Diff
+}
+
+// diffSections splits diffs already grouped by type into one section per type.
+func diffSections(diffs []Diff) []diffSection {
+
+	var sections []diffSection
+	for _, d := range diffs {
+		last := len(sections) - 1
+		if last < 0 || sections[last].Type != d.Type {
+			sections = append(sections, diffSection{Type: d.Type, Class: diffClass(d.Type)})
+			last++
+		}
+		sections[last].Diffs = append(sections[last].Diffs, d)
+	}
+	return sections
+}
+
+type htmlReport struct {
+	Title string
+	Pairs []htmlPair
+}
+
+// htmlPair is the part of an HTML report comparing two schemas, under its
+// heading, with the error of the comparison when it failed.
+type htmlPair struct {
+	Heading  string
+	A        string
+	B        string
+	Summary  string
+	Error    string
+	Sections []diffSection
+}
+
+func newHTMLPair(heading string, diffs []Diff, aName string, bName string) htmlPair {
+	return htmlPair{
+		Heading:  heading,
+		A:        aName,
+		B:        bName,
+		Summary:  summaryLine(summarise(diffs)),
+		Sections: diffSections(diffs),
+	}
+}
+
+// renderHTML renders a self-contained HTML report with one section per diff
+// type. The diffs are expected to be grouped by type already.
+func renderHTML(diffs []Diff, aName string, bName string) string {
+	title := fmt.Sprintf("%s vs %s", aName, bName)
+	return renderHTMLReport(htmlReport{Title: title, Pairs: []htmlPair{newHTMLPair(title, diffs, aName, bName)}})
+}
+
+func renderHTMLReport(report htmlReport) string {
+	var b strings.Builder
+	if err := reportTemplate.Execute(&b, report); err != nil {
+		return fmt.Sprintf("<!-- error rendering report: %s -->\n", html.EscapeString(err.Error()))
+	}
+	return b.String()
+}
+
+func diffClass(diffType string) string {
+	switch {
+	case strings.HasPrefix(diffType, "MISSING_"):
+		return "missing"
+	case strings.HasPrefix(diffType, "WRONG_"):
+		return "wrong"
+	case strings.HasPrefix(diffType, "EXTRA_"):
+		return "extra"
+	}
+	return ""
+}
+
+// renderMarkdown renders a GitHub Flavored Markdown report with one table per
+// diff type. The diffs are expected to be grouped by type already.
+func renderMarkdown(diffs []Diff, aName string, bName string) string {
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## SQLCompare: `%s` vs `%s`\n\n", aName, bName)
+
+	for _, section := range diffSections(diffs) {
+		fmt.Fprintf(&b, "### %s\n\n", diffTypeTitle(section.Type))
+		fmt.Fprintf(&b, "| Target | %s | %s |\n", markdownCell(aName), markdownCell(bName))
+		b.WriteString("| --- | --- | --- |\n")
+		for _, d := range section.Diffs {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(d.Target), markdownCell(d.A), markdownCell(d.B))
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "**Summary:** %s\n", summaryLine(summarise(diffs)))
+	return b.String()
+}
+
+// renderJira renders a table in JIRA wiki markup, flagging the diffs that
+// break existing data or queries.
+func renderJira(diffs []Diff, aName string, bName string) string {
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "h2. SQLCompare: %s vs %s\n\n", jiraCell(aName), jiraCell(bName))
+
+	if len(diffs) > 0 {
+		fmt.Fprintf(&b, "||Type||Target||%s||%s||\n", jiraCell(aName), jiraCell(bName))
+	}
+	for _, d := range diffs {
+		diffType := d.Type
+		if isBreaking(d) {
+			diffType += " {color:red}BREAKING{color}"
+		}
+		fmt.Fprintf(&b, "|%s|%s|%s|%s|\n", diffType, jiraCell(d.Target), jiraCell(d.A), jiraCell(d.B))
+	}
+
+	fmt.Fprintf(&b, "\n*Summary:* %s\n", summaryLine(summarise(diffs)))
+	return b.String()
+}
+
+// reportSummary is the line printed to the terminal when the full report
+// goes to a file, with the number of diffs of each severity.
+func reportSummary(diffs []Diff, path string) string {
+	counts := make(map[string]int)
+	for _, d := range diffs {
+		counts[diffSeverity(d)]++
+	}
+
+	noun := "diffs"
+	if len(diffs) == 1 {
+		noun = "diff"
+	}
+	return fmt.Sprintf("%d %s found (%d breaking, %d warning, %d info). Full report written to %s",
+		len(diffs), noun, counts[SeverityBreaking], counts[SeverityWarning], counts[SeverityInfo], path)
+}
+
+func jiraCell(s string) string {
+	if s == "" {
+		return " "
+	}
+	s = strings.NewReplacer("|", "\\|", "{", "\\{", "}", "\\}", "[", "\\[", "]", "\\]").Replace(s)
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// diffTypeTitle turns MISSING_TABLE into "Missing Tables".
+func diffTypeTitle(diffType string) string {
+	words := strings.Split(strings.ToLower(diffType), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return plural(strings.Join(words, " "))
+}
+
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestReportSummary(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		diffs []Diff
+		want  string
+	}{
+		{"no diffs", nil, "0 diffs found (0 breaking, 0 warning, 0 info). Full report written to report.html"},
+		{"one diff", []Diff{{Type: MissingTable, Target: "users"}}, "1 diff found (1 breaking, 0 warning, 0 info). Full report written to report.html"},
+		{"every severity", []Diff{
+			{Type: MissingTable, Target: "users"},
+			{Type: MissingColumn, Target: "orders", A: "total"},
+			{Type: WrongViewDefinition, Target: "v"},
+			{Type: WrongColumnOther, Target: "orders.id"},
+			{Type: WrongIndexName, Target: "orders.user_id"},
+		}, "5 diffs found (2 breaking, 2 warning, 1 info). Full report written to report.html"},
+		{"severity set by the comparison", []Diff{
+			{Type: ExtraColumn, Target: "orders", A: "total", Severity: SeverityBreaking},
+			{Type: ExtraColumn, Target: "orders", A: "note"},
+		}, "2 diffs found (1 breaking, 0 warning, 1 info). Full report written to report.html"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reportSummary(tt.diffs, "report.html"); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
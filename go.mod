@@ -1,3 +1,8 @@
 module SQLCompare
 
 go 1.16
+
+require (
+	github.com/go-sql-driver/mysql v1.6.0
+	gopkg.in/yaml.v3 v3.0.1
+)
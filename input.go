@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// stdinPath is the file path that reads a schema from standard input, e.g.
+// mysqldump ... | sqlcompare - schema.sql
+const stdinPath = "-"
+
+// httpClient fetches the schemas given as http:// or https:// URLs, its
+// timeout set by -http-timeout.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// readInput reads the schema file at path, standard input when path is
+// stdinPath, or the response body when path is an http:// or https:// URL.
+func readInput(path string) ([]byte, error) {
+	f, err := openInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// openInput opens what readInput reads, for it to be read as a stream.
+func openInput(path string) (io.ReadCloser, error) {
+	if path == stdinPath {
+		return ioutil.NopCloser(os.Stdin), nil
+	}
+	if isURL(path) {
+		return fetchURL(path)
+	}
+	return os.Open(path)
+}
+
+func isURL(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+func fetchURL(url string) (io.ReadCloser, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+	return resp.Body, nil
+}
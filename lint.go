@@ -0,0 +1,526 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	MissingSoftDeleteColumn = "MISSING_SOFT_DELETE_COLUMN"
+	MissingAuditColumn      = "MISSING_AUDIT_COLUMN"
+	TooManyIndexes          = "TOO_MANY_INDEXES"
+	TooManyCompositeIndexes = "TOO_MANY_COMPOSITE_INDEXES"
+	TableNameTooLong        = "TABLE_NAME_TOO_LONG"
+	ColumnNameTooLong       = "COLUMN_NAME_TOO_LONG"
+	IndexNameTooLong        = "INDEX_NAME_TOO_LONG"
+	FKColumnMissingIndex    = "FK_COLUMN_MISSING_INDEX"
+	NamingViolation         = "NAMING_VIOLATION"
+	ConsiderVarchar         = "CONSIDER_VARCHAR_INSTEAD_OF_TEXT"
+	NonUTF8MB4Charset       = "NON_UTF8MB4_CHARSET"
+	MissingPrimaryKey       = "MISSING_PRIMARY_KEY"
+	TableTooWide            = "TABLE_TOO_WIDE"
+)
+
+const maxCompositeIndexes = 5
+
+// words in column names holding values short enough for a VARCHAR(255)
+var shortTextWords = []string{"title", "name", "slug", "code"}
+
+type LintWarning struct {
+	Rule    string `json:"rule"`
+	Target  string `json:"target"`
+	Message string `json:"message"`
+}
+
+type LintOptions struct {
+	SoftDelete        bool
+	SoftDeleteColumns []string
+	AuditColumns      bool
+	AuditColumnNames  []string
+	IndexCount        bool
+	MaxIndexes        int
+	FKIndexes         bool
+	LongText          bool
+	UTF8MB4           bool
+	// -require-primary-keys, which also fails the run, for the tables not
+	// matching ExcludeTables
+	RequirePrimaryKeys bool
+	ExcludeTables      []*regexp.Regexp
+	// 0 disables the matching name length rule
+	MaxTableNameLength  int
+	MaxColumnNameLength int
+	MaxIndexNameLength  int
+	// number of columns above which a table is too wide, 0 to disable
+	WideTableColumns int
+	Naming           NamingPatterns
+}
+
+// NamingPatterns are the naming conventions checked by -check-naming-pattern,
+// nil for the object types without one.
+type NamingPatterns struct {
+	Table  *regexp.Regexp
+	Column *regexp.Regexp
+	Index  *regexp.Regexp
+	FK     *regexp.Regexp
+}
+
+func lintTables(tables map[string]Table, opts LintOptions) []LintWarning {
+
+	warnings := make([]LintWarning, 0)
+
+	tableNames := make([]string, 0, len(tables))
+	for name := range tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	for _, name := range tableNames {
+		table := tables[name]
+
+		if opts.SoftDelete {
+			warnings = append(warnings, lintSoftDelete(table, opts.SoftDeleteColumns)...)
+		}
+
+		if opts.AuditColumns && !isJunctionTable(table) {
+			warnings = append(warnings, lintAuditColumns(table, opts.AuditColumnNames)...)
+		}
+
+		if opts.IndexCount {
+			warnings = append(warnings, lintIndexCount(table, opts.MaxIndexes)...)
+		}
+
+		if opts.FKIndexes {
+			warnings = append(warnings, lintFKIndexes(table)...)
+		}
+
+		if opts.LongText {
+			warnings = append(warnings, lintLongText(table)...)
+		}
+
+		if opts.UTF8MB4 {
+			warnings = append(warnings, lintCharsets(table)...)
+		}
+
+		if opts.RequirePrimaryKeys && !matchesAny(opts.ExcludeTables, table.Name) && !hasPrimaryKey(table) {
+			warnings = append(warnings, LintWarning{
+				Rule:    MissingPrimaryKey,
+				Target:  table.Name,
+				Message: "no primary key, InnoDB clusters rows on a hidden one and row-based replication scans the table for every changed row",
+			})
+		}
+
+		if opts.WideTableColumns > 0 && len(table.Columns) > opts.WideTableColumns {
+			warnings = append(warnings, LintWarning{
+				Rule:    TableTooWide,
+				Target:  table.Name,
+				Message: fmt.Sprintf("%d columns, more than %d, often a denormalized or catch-all table", len(table.Columns), opts.WideTableColumns),
+			})
+		}
+
+		warnings = append(warnings, lintNameLengths(table, opts)...)
+		warnings = append(warnings, lintNaming(table, opts.Naming)...)
+	}
+
+	return warnings
+}
+
+// lintSoftDelete warns about tables without a soft-delete column: one of
+// columnNames holding a deletion time, DATETIME or TIMESTAMP, or a deleted
+// flag, TINYINT(1) or BOOLEAN. A column with the name but another type,
+// e.g. deleted_at VARCHAR(10), is reported on its own.
+func lintSoftDelete(table Table, columnNames []string) []LintWarning {
+
+	var wrongType []LintWarning
+	for _, name := range columnNames {
+		column, exists := table.Columns[name]
+		if !exists {
+			continue
+		}
+		if isSoftDeleteType(column.Type) {
+			return nil
+		}
+		wrongType = append(wrongType, LintWarning{
+			Rule:    MissingSoftDeleteColumn,
+			Target:  table.Name + "." + name,
+			Message: fmt.Sprintf("soft-delete column %s is %s, not DATETIME, TIMESTAMP or TINYINT(1)", name, column.Type),
+		})
+	}
+	if len(wrongType) > 0 {
+		return wrongType
+	}
+
+	return []LintWarning{{
+		Rule:    MissingSoftDeleteColumn,
+		Target:  table.Name,
+		Message: fmt.Sprintf("no soft-delete column (%s), rows will be hard-deleted", strings.Join(columnNames, ", ")),
+	}}
+}
+
+// isSoftDeleteType reports whether a column of the type can mark a row as
+// deleted: a DATETIME or TIMESTAMP, with any fractional seconds precision,
+// or a boolean flag.
+func isSoftDeleteType(columnType string) bool {
+	switch lower := strings.ToLower(columnType); {
+	case lower == "tinyint(1)", lower == "bool", lower == "boolean", lower == "bit(1)":
+		return true
+	default:
+		base := strings.SplitN(lower, "(", 2)[0]
+		return base == "datetime" || base == "timestamp"
+	}
+}
+
+func lintAuditColumns(table Table, columnNames []string) []LintWarning {
+
+	var warnings []LintWarning
+	for _, name := range table.MissingRequiredColumns(columnNames) {
+		warnings = append(warnings, LintWarning{
+			Rule:    MissingAuditColumn,
+			Target:  table.Name,
+			Message: fmt.Sprintf("missing audit column %s", name),
+		})
+	}
+	return warnings
+}
+
+// lintIndexCount warns about tables with more secondary indexes than
+// maxIndexes, each one slowing down writes, and about tables with many
+// composite indexes.
+func lintIndexCount(table Table, maxIndexes int) []LintWarning {
+
+	var columnLists []string
+	for _, index := range table.Indexes {
+		columnLists = append(columnLists, index.ColumnName)
+	}
+	for _, columnConstraints := range table.Constraints {
+		if unique, exists := columnConstraints["UNIQUE"]; exists {
+			columnLists = append(columnLists, unique.ColumnName)
+		}
+	}
+
+	composite := 0
+	for _, columnList := range columnLists {
+		if strings.Contains(columnList, ",") {
+			composite++
+		}
+	}
+
+	var warnings []LintWarning
+	if len(columnLists) > maxIndexes {
+		warnings = append(warnings, LintWarning{
+			Rule:    TooManyIndexes,
+			Target:  table.Name,
+			Message: fmt.Sprintf("%d indexes, more than %d slow down writes", len(columnLists), maxIndexes),
+		})
+	}
+	if composite > maxCompositeIndexes {
+		warnings = append(warnings, LintWarning{
+			Rule:    TooManyCompositeIndexes,
+			Target:  table.Name,
+			Message: fmt.Sprintf("%d composite indexes, more than %d", composite, maxCompositeIndexes),
+		})
+	}
+	return warnings
+}
+
+// hasPrimaryKey reports whether the table has a PRIMARY KEY, declared on its
+// own or on a column.
+func hasPrimaryKey(table Table) bool {
+	for _, columnConstraints := range table.Constraints {
+		if _, exists := columnConstraints["PRIMARY"]; exists {
+			return true
+		}
+	}
+	for _, column := range table.Columns {
+		if findWord(column.Other, "PRIMARY KEY") >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRule reports whether any of the warnings is for the rule.
+func hasRule(warnings []LintWarning, rule string) bool {
+	for _, warning := range warnings {
+		if warning.Rule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// lintFKIndexes warns about foreign keys whose columns are not the leading
+// columns of an index, so every DELETE or UPDATE of the referenced table
+// scans this one.
+func lintFKIndexes(table Table) []LintWarning {
+
+	var columnLists []string
+	for _, index := range table.Indexes {
+		columnLists = append(columnLists, index.ColumnName)
+	}
+	for _, columnConstraints := range table.Constraints {
+		for _, constraint := range columnConstraints {
+			if constraint.Type == "PRIMARY" || constraint.Type == "UNIQUE" {
+				columnLists = append(columnLists, constraint.ColumnName)
+			}
+		}
+	}
+	isIndexed := func(columnName string) bool {
+		for _, columnList := range columnLists {
+			if columnList == columnName || strings.HasPrefix(columnList, columnName+",") {
+				return true
+			}
+		}
+		return false
+	}
+
+	var foreignKeys []string
+	for columnName, columnConstraints := range table.Constraints {
+		if _, isForeignKey := columnConstraints["FOREIGN"]; isForeignKey && !isIndexed(columnName) {
+			foreignKeys = append(foreignKeys, columnName)
+		}
+	}
+	sort.Strings(foreignKeys)
+
+	var warnings []LintWarning
+	for _, columnName := range foreignKeys {
+		warnings = append(warnings, LintWarning{
+			Rule:    FKColumnMissingIndex,
+			Target:  table.Name + "." + columnName,
+			Message: fmt.Sprintf("foreign key %s has no index, changes to the referenced table scan this one", table.Constraints[columnName]["FOREIGN"].Name),
+		})
+	}
+	return warnings
+}
+
+// lintLongText suggests a VARCHAR for the TEXT and BLOB columns whose names
+// say they hold short values, like title or user_name. TEXT and BLOB columns
+// can only be indexed on a prefix and their values are stored off-page.
+func lintLongText(table Table) []LintWarning {
+
+	var warnings []LintWarning
+	for _, column := range table.SortedColumns() {
+		columnType := strings.ToLower(leadingValue(column.Type))
+		if !strings.HasSuffix(columnType, "text") && !strings.HasSuffix(columnType, "blob") {
+			continue
+		}
+
+		name := strings.ToLower(column.Name)
+		for _, word := range shortTextWords {
+			if name == word || strings.HasPrefix(name, word+"_") || strings.HasSuffix(name, "_"+word) {
+				warnings = append(warnings, LintWarning{
+					Rule:    ConsiderVarchar,
+					Target:  table.Name + "." + column.Name,
+					Message: fmt.Sprintf("%s column for a %s, a VARCHAR(255) or smaller can be indexed and kept in the row", column.Type, word),
+				})
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// lintCharsets warns about a table or column character set other than
+// utf8mb4. MySQL's utf8 is utf8mb3, which cannot store 4-byte characters
+// like emoji. binary is left alone, it holds bytes and not text.
+func lintCharsets(table Table) []LintWarning {
+
+	var warnings []LintWarning
+	check := func(target string, charset string) {
+		if charset == "" || charset == "utf8mb4" || charset == "binary" {
+			return
+		}
+		message := fmt.Sprintf("character set %s cannot store every Unicode character, use utf8mb4", charset)
+		if charset == "utf8" || charset == "utf8mb3" {
+			message = fmt.Sprintf("character set %s is utf8mb3, which cannot store 4-byte characters like emoji, use utf8mb4", charset)
+		}
+		warnings = append(warnings, LintWarning{Rule: NonUTF8MB4Charset, Target: target, Message: message})
+	}
+
+	check(table.Name, table.Charset)
+	for _, column := range table.SortedColumns() {
+		check(table.Name+"."+column.Name, charsetOption(column.Other))
+	}
+	return warnings
+}
+
+// lintNameLengths warns about identifiers longer than the configured limits,
+// which some tools truncate and other databases reject.
+func lintNameLengths(table Table, opts LintOptions) []LintWarning {
+
+	var warnings []LintWarning
+	check := func(rule string, kind string, target string, name string, max int) {
+		if max > 0 && len(name) > max {
+			warnings = append(warnings, LintWarning{
+				Rule:    rule,
+				Target:  target,
+				Message: fmt.Sprintf("%s name %s is %d characters long, more than %d", kind, name, len(name), max),
+			})
+		}
+	}
+
+	check(TableNameTooLong, "table", table.Name, table.Name, opts.MaxTableNameLength)
+	for _, column := range table.SortedColumns() {
+		check(ColumnNameTooLong, "column", table.Name+"."+column.Name, column.Name, opts.MaxColumnNameLength)
+	}
+
+	var indexNames []string
+	for _, index := range table.Indexes {
+		indexNames = append(indexNames, index.Name)
+	}
+	for _, columnConstraints := range table.Constraints {
+		for _, constraint := range columnConstraints {
+			if constraint.Type != "PRIMARY" {
+				indexNames = append(indexNames, constraint.Name)
+			}
+		}
+	}
+	sort.Strings(indexNames)
+	for _, name := range indexNames {
+		check(IndexNameTooLong, "index", table.Name+"."+name, name, opts.MaxIndexNameLength)
+	}
+
+	return warnings
+}
+
+// lintNaming warns about tables, columns, indexes and foreign keys whose
+// names do not match the naming convention for their type. Unique keys are
+// checked against the index pattern.
+func lintNaming(table Table, patterns NamingPatterns) []LintWarning {
+
+	var warnings []LintWarning
+	check := func(pattern *regexp.Regexp, kind string, target string, name string) {
+		if pattern != nil && !pattern.MatchString(name) {
+			warnings = append(warnings, LintWarning{
+				Rule:    NamingViolation,
+				Target:  target,
+				Message: fmt.Sprintf("%s name %s does not match %s", kind, name, pattern),
+			})
+		}
+	}
+
+	check(patterns.Table, "table", table.Name, table.Name)
+	for _, column := range table.SortedColumns() {
+		check(patterns.Column, "column", table.Name+"."+column.Name, column.Name)
+	}
+	for _, index := range table.SortedIndexes() {
+		check(patterns.Index, "index", table.Name+"."+index.Name, index.Name)
+	}
+	for _, constraint := range table.SortedConstraints() {
+		switch constraint.Type {
+		case "UNIQUE":
+			check(patterns.Index, "index", table.Name+"."+constraint.Name, constraint.Name)
+		case "FOREIGN":
+			check(patterns.FK, "foreign key", table.Name+"."+constraint.Name, constraint.Name)
+		}
+	}
+
+	return warnings
+}
+
+// readNamingPatterns reads a naming convention file with one
+// key: "regular expression" line per object type, out of tablePattern,
+// columnPattern, indexPattern and fkPattern.
+func readNamingPatterns(path string) (NamingPatterns, error) {
+
+	var patterns NamingPatterns
+	lines, err := readListFile(path)
+	if err != nil {
+		return patterns, err
+	}
+
+	for _, line := range lines {
+		colon := strings.IndexByte(line, ':')
+		if colon < 0 {
+			return patterns, fmt.Errorf("expected key: pattern, got %s", line)
+		}
+		key := strings.TrimSpace(line[:colon])
+		value := strings.TrimSpace(line[colon+1:])
+		if len(value) > 1 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		pattern, err := regexp.Compile(value)
+		if err != nil {
+			return patterns, fmt.Errorf("%s: %v", key, err)
+		}
+		switch key {
+		case "tablePattern":
+			patterns.Table = pattern
+		case "columnPattern":
+			patterns.Column = pattern
+		case "indexPattern":
+			patterns.Index = pattern
+		case "fkPattern":
+			patterns.FK = pattern
+		default:
+			return patterns, fmt.Errorf("unknown key: %s", key)
+		}
+	}
+	return patterns, nil
+}
+
+// isJunctionTable reports whether the table only holds two foreign key
+// columns, as many-to-many link tables do.
+func isJunctionTable(table Table) bool {
+	if len(table.Columns) != 2 {
+		return false
+	}
+
+	for name := range table.Columns {
+		if _, isForeignKey := table.Constraints[name]["FOREIGN"]; !isForeignKey {
+			return false
+		}
+	}
+	return true
+}
+
+// readListFile reads one item per line, ignoring blank lines and # comments.
+func readListFile(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		res = append(res, line)
+	}
+	return res, nil
+}
+
+func splitList(list string) []string {
+	var res []string
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+func printLintWarnings(out io.Writer, warnings []LintWarning, fileName string) {
+	if len(warnings) == 0 {
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(out, "\nLint: %s\n\n", fileName)
+	fmt.Fprintf(w, "Rule\t|\tTarget\t|\tMessage\n")
+	for _, warning := range warnings {
+
+		fmt.Fprintf(w, "%v\t|\t%v\t|\t%v\n", warning.Rule, warning.Target, warning.Message)
+	}
+
+	w.Flush()
+	fmt.Fprintln(out)
+}
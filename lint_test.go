@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLintSoftDelete(t *testing.T) {
+
+	tests := []struct {
+		column string
+		// the targets of the warnings, none when the table passes
+		want []string
+	}{
+		{"`deleted_at` datetime DEFAULT NULL", nil},
+		{"`deleted_at` datetime(6) DEFAULT NULL", nil},
+		{"`deleted_at` timestamp NULL DEFAULT NULL", nil},
+		{"`is_deleted` tinyint(1) NOT NULL DEFAULT '0'", nil},
+		{"`is_deleted` BOOLEAN NOT NULL DEFAULT FALSE", nil},
+		{"`deleted_at` varchar(10) DEFAULT NULL", []string{"users.deleted_at"}},
+		{"`is_deleted` int NOT NULL DEFAULT '0'", []string{"users.is_deleted"}},
+		{"`removed_at` datetime DEFAULT NULL", []string{"users"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			table := mustParseTables(t, fmt.Sprintf("CREATE TABLE `users` (\n"+
+				"  `id` int NOT NULL,\n"+
+				"  %s,\n"+
+				"  PRIMARY KEY (`id`)\n"+
+				") ENGINE=InnoDB;\n", tt.column))["users"]
+
+			warnings := lintSoftDelete(table, []string{"deleted_at", "is_deleted"})
+			if len(warnings) != len(tt.want) {
+				t.Fatalf("got warnings %+v, want %d", warnings, len(tt.want))
+			}
+			for i, warning := range warnings {
+				if warning.Rule != MissingSoftDeleteColumn || warning.Target != tt.want[i] {
+					t.Errorf("got warning %+v, want %s on %s", warning, MissingSoftDeleteColumn, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLintSoftDeleteAnyAcceptedColumn(t *testing.T) {
+
+	table := mustParseTables(t, "CREATE TABLE `users` (\n"+
+		"  `id` int NOT NULL,\n"+
+		"  `deleted_at` varchar(10) DEFAULT NULL,\n"+
+		"  `is_deleted` tinyint(1) NOT NULL DEFAULT '0',\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		") ENGINE=InnoDB;\n")["users"]
+
+	if warnings := lintSoftDelete(table, []string{"deleted_at", "is_deleted"}); len(warnings) > 0 {
+		t.Errorf("unexpected warnings: %+v", warnings)
+	}
+}
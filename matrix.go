@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"strings"
+)
+
+// compatibilityMatrix returns the number of diffs between every pair of
+// schemas, counts[i][j] being the diffs reported comparing i with j.
+func compatibilityMatrix(schemas []map[string]Table, opts runOptions) [][]int {
+
+	counts := make([][]int, len(schemas))
+	for i := range schemas {
+		counts[i] = make([]int, len(schemas))
+		for j := range schemas {
+			if i != j {
+				counts[i][j] = len(filterDiffTypes(compareTables(schemas[i], schemas[j], opts.Compare), opts.DiffTypes))
+			}
+		}
+	}
+	return counts
+}
+
+// renderMatrixMarkdown renders the matrix with one row per first schema and
+// one column per second schema.
+func renderMatrixMarkdown(paths []string, counts [][]int) string {
+
+	var b strings.Builder
+	b.WriteString("| |")
+	for _, path := range paths {
+		fmt.Fprintf(&b, " %s |", markdownCell(path))
+	}
+	b.WriteString("\n| --- |")
+	for range paths {
+		b.WriteString(" ---: |")
+	}
+	b.WriteString("\n")
+
+	for i, path := range paths {
+		fmt.Fprintf(&b, "| **%s** |", markdownCell(path))
+		for j := range paths {
+			fmt.Fprintf(&b, " %s |", matrixCell(i, j, counts))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func renderMatrixHTML(paths []string, counts [][]int) string {
+
+	var b strings.Builder
+	b.WriteString("<table>\n<tr><th></th>")
+	for _, path := range paths {
+		fmt.Fprintf(&b, "<th>%s</th>", html.EscapeString(path))
+	}
+	b.WriteString("</tr>\n")
+
+	for i, path := range paths {
+		fmt.Fprintf(&b, "<tr><th>%s</th>", html.EscapeString(path))
+		for j := range paths {
+			fmt.Fprintf(&b, "<td>%s</td>", matrixCell(i, j, counts))
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</table>\n")
+	return b.String()
+}
+
+func matrixCell(i int, j int, counts [][]int) string {
+	if i == j {
+		return "-"
+	}
+	return fmt.Sprint(counts[i][j])
+}
+
+// runSummaryTable compares every pair of schema files and prints the number
+// of diffs of each pair as a Markdown or HTML table. It reports whether any
+// two schemas differ.
+func runSummaryTable(opts runOptions, paths []string) (bool, error) {
+
+	var schemas []map[string]Table
+	for _, path := range paths {
+		tables, _, err := parseFile(path, opts)
+		if err != nil {
+			return false, fmt.Errorf("error reading file: %s, %v", path, err)
+		}
+		schemas = append(schemas, tables)
+	}
+	counts := compatibilityMatrix(schemas, opts)
+
+	var table string
+	switch opts.Format {
+	case "text", "markdown":
+		table = renderMatrixMarkdown(paths, counts)
+	case "html":
+		table = renderMatrixHTML(paths, counts)
+	default:
+		return false, fmt.Errorf("-summary-table only supports the markdown and html formats")
+	}
+
+	var w io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return false, fmt.Errorf("error creating output file: %s, %v", opts.Output, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if !opts.Quiet || opts.Output != "" {
+		if _, err := fmt.Fprint(w, table); err != nil {
+			return false, err
+		}
+	}
+
+	for i := range counts {
+		for j := range counts[i] {
+			if counts[i][j] > 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+)
+
+// Conflict is an object both branches changed differently since the base.
+// The definitions are empty where the object does not exist.
+type Conflict struct {
+	Target string `json:"target"`
+	Base   string `json:"base"`
+	Ours   string `json:"ours"`
+	Theirs string `json:"theirs"`
+}
+
+// MergeSchemas applies the changes made from base to ours and from base to
+// theirs to the same schema, the way git merges two branches. A table,
+// column, index or constraint changed in both branches in different ways is
+// a conflict and the merged schema keeps the version from ours.
+func MergeSchemas(base, ours, theirs map[string]Table) (map[string]Table, []Conflict, error) {
+
+	merged := make(map[string]Table)
+	var conflicts []Conflict
+
+	names := make(map[string]bool)
+	for _, tables := range []map[string]Table{base, ours, theirs} {
+		for name := range tables {
+			names[name] = true
+		}
+	}
+	tableNames := make([]string, 0, len(names))
+	for name := range names {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	for _, name := range tableNames {
+		tableBase, inBase := base[name]
+		tableOurs, inOurs := ours[name]
+		tableTheirs, inTheirs := theirs[name]
+
+		switch {
+		case !inOurs && !inTheirs:
+			continue
+		case inBase && (!inOurs || !inTheirs):
+			// dropped in one branch, which only merges if the other one left
+			// the table as it was
+			kept, keptIn := tableOurs, inOurs
+			if !inOurs {
+				kept = tableTheirs
+			}
+			if createTableSQL(kept) == createTableSQL(tableBase) {
+				continue
+			}
+			conflict := Conflict{Target: name, Base: createTableSQL(tableBase)}
+			if keptIn {
+				conflict.Ours = createTableSQL(kept)
+			} else {
+				conflict.Theirs = createTableSQL(kept)
+			}
+			conflicts = append(conflicts, conflict)
+			merged[name] = kept
+		case !inBase && inOurs != inTheirs:
+			merged[name] = tableOurs
+			if inTheirs {
+				merged[name] = tableTheirs
+			}
+		default:
+			if !inBase {
+				tableBase = newTable(name)
+			}
+			table, tableConflicts := mergeTable(tableBase, tableOurs, tableTheirs)
+			merged[name] = table
+			conflicts = append(conflicts, tableConflicts...)
+		}
+	}
+
+	return merged, conflicts, nil
+}
+
+func mergeTable(base, ours, theirs Table) (Table, []Conflict) {
+
+	table := newTable(ours.Name)
+	var conflicts []Conflict
+	prefix := ours.Name + "."
+
+	optionDefs := func(t Table) map[string]string {
+		return map[string]string{"options": tableOptionsClause(t.Options)}
+	}
+	fromTheirs, optionConflicts := mergeDefinitions(prefix, optionDefs(base), optionDefs(ours), optionDefs(theirs))
+	conflicts = append(conflicts, optionConflicts...)
+	table.Options = ours.Options
+	if fromTheirs["options"] {
+		table.Options = theirs.Options
+	}
+
+	columnDefs := func(t Table) map[string]string {
+		defs := make(map[string]string)
+		for name, column := range t.Columns {
+			defs[name] = columnDefinition(column)
+		}
+		return defs
+	}
+	fromTheirs, columnConflicts := mergeDefinitions(prefix, columnDefs(base), columnDefs(ours), columnDefs(theirs))
+	conflicts = append(conflicts, columnConflicts...)
+
+	// the columns keep the order of ours, those added in theirs go last,
+	// copied whole so the attributes newColumn strips from Other are kept
+	for _, column := range ours.SortedColumns() {
+		if useTheirs, keep := fromTheirs[column.Name]; keep {
+			if useTheirs {
+				column = theirs.Columns[column.Name]
+			}
+			column.Ordinal = len(table.Columns)
+			table.Columns[column.Name] = column
+		}
+	}
+	for _, column := range theirs.SortedColumns() {
+		if _, added := table.Columns[column.Name]; !added && fromTheirs[column.Name] {
+			column.Ordinal = len(table.Columns)
+			table.Columns[column.Name] = column
+		}
+	}
+
+	indexDefs := func(t Table) map[string]string {
+		defs := make(map[string]string)
+		for columnName, index := range t.Indexes {
+			defs[columnName] = indexDefinition(index)
+		}
+		return defs
+	}
+	fromTheirs, indexConflicts := mergeDefinitions(prefix, indexDefs(base), indexDefs(ours), indexDefs(theirs))
+	conflicts = append(conflicts, indexConflicts...)
+	for columnName, useTheirs := range fromTheirs {
+		table.Indexes[columnName] = ours.Indexes[columnName]
+		if useTheirs {
+			table.Indexes[columnName] = theirs.Indexes[columnName]
+		}
+	}
+
+	constraintKey := func(c Constraint) string {
+		return c.ColumnName + "." + c.Type
+	}
+	constraintDefs := func(t Table) map[string]string {
+		defs := make(map[string]string)
+		for _, constraint := range t.SortedConstraints() {
+			defs[constraintKey(constraint)] = withMatchType(constraintDefinition(constraint), constraint.MatchType)
+		}
+		return defs
+	}
+	fromTheirs, constraintConflicts := mergeDefinitions(prefix, constraintDefs(base), constraintDefs(ours), constraintDefs(theirs))
+	conflicts = append(conflicts, constraintConflicts...)
+	for _, constraint := range ours.SortedConstraints() {
+		if useTheirs, keep := fromTheirs[constraintKey(constraint)]; keep && !useTheirs {
+			addConstraint(table, constraint)
+		}
+	}
+	for _, constraint := range theirs.SortedConstraints() {
+		if fromTheirs[constraintKey(constraint)] {
+			addConstraint(table, constraint)
+		}
+	}
+
+	return table, conflicts
+}
+
+// mergeDefinitions merges the definitions of one kind of object, by key, and
+// returns the keys of the merged objects, true for those to take from
+// theirs. Conflicting objects are kept from ours.
+func mergeDefinitions(prefix string, base, ours, theirs map[string]string) (map[string]bool, []Conflict) {
+
+	keys := make(map[string]bool)
+	for _, defs := range []map[string]string{base, ours, theirs} {
+		for key := range defs {
+			keys[key] = true
+		}
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	fromTheirs := make(map[string]bool)
+	var conflicts []Conflict
+	for _, key := range sortedKeys {
+		b, inBase := base[key]
+		o, inOurs := ours[key]
+		t, inTheirs := theirs[key]
+
+		switch {
+		case inOurs == inTheirs && o == t, inTheirs == inBase && t == b:
+			// unchanged in theirs
+			if inOurs {
+				fromTheirs[key] = false
+			}
+		case inOurs == inBase && o == b:
+			// unchanged in ours
+			if inTheirs {
+				fromTheirs[key] = true
+			}
+		default:
+			conflicts = append(conflicts, Conflict{Target: prefix + key, Base: b, Ours: o, Theirs: t})
+			if inOurs {
+				fromTheirs[key] = false
+			}
+		}
+	}
+	return fromTheirs, conflicts
+}
+
+func printConflicts(out io.Writer, conflicts []Conflict) {
+	w := tabwriter.NewWriter(out, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(out, "\n\nConflicts\n\n")
+	fmt.Fprintf(w, "Target\t|\tBase\t|\tOurs\t|\tTheirs\n")
+	for _, c := range conflicts {
+		fmt.Fprintf(w, "%v\t|\t%v\t|\t%v\t|\t%v\n", c.Target, conflictCell(c.Base), conflictCell(c.Ours), conflictCell(c.Theirs))
+	}
+	w.Flush()
+	word := "conflict"
+	if len(conflicts) > 1 {
+		word = plural(word)
+	}
+	fmt.Fprintf(out, "\n%d %s\n", len(conflicts), word)
+}
+
+func conflictCell(def string) string {
+	if def == "" {
+		return "(none)"
+	}
+	return firstLine(def)
+}
+
+// runMerge merges the three schema files and prints the merged schema, or
+// the conflicts when there are any. It reports whether there were conflicts.
+func runMerge(opts runOptions, pathBase string, pathOurs string, pathTheirs string) (bool, error) {
+
+	var schemas []map[string]Table
+	for _, path := range []string{pathBase, pathOurs, pathTheirs} {
+		tables, _, err := parseFile(path, opts)
+		if err != nil {
+			return false, fmt.Errorf("error reading file: %s, %v", path, err)
+		}
+		schemas = append(schemas, tables)
+	}
+
+	merged, conflicts, err := MergeSchemas(schemas[0], schemas[1], schemas[2])
+	if err != nil {
+		return false, err
+	}
+
+	if len(conflicts) > 0 {
+		if !opts.Quiet {
+			printConflicts(os.Stdout, conflicts)
+		}
+		return true, nil
+	}
+
+	var w io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return false, fmt.Errorf("error creating output file: %s, %v", opts.Output, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	tableNames := make([]string, 0, len(merged))
+	for name := range merged {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	var statements []string
+	for _, name := range tableNames {
+		statements = append(statements, createTableSQL(merged[name]))
+	}
+	_, err = fmt.Fprint(w, joinStatements(statements))
+	return false, err
+}
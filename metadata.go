@@ -0,0 +1,120 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// metadataTable is the JSON definition of a table in a metadata database.
+// A definition that is a JSON array holds the columns only.
+type metadataTable struct {
+	Columns     []metadataColumn     `json:"columns"`
+	Indexes     []metadataIndex      `json:"indexes"`
+	Constraints []metadataConstraint `json:"constraints"`
+}
+
+type metadataColumn struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Other string `json:"other"`
+}
+
+// indexes and constraints name a single column or a list of them
+type metadataIndex struct {
+	Name    string   `json:"name"`
+	Column  string   `json:"column"`
+	Columns []string `json:"columns"`
+	Kind    string   `json:"kind"`
+}
+
+type metadataConstraint struct {
+	Name    string   `json:"name"`
+	Column  string   `json:"column"`
+	Columns []string `json:"columns"`
+	Type    string   `json:"type"`
+	Other   string   `json:"other"`
+}
+
+// ParseMetadataDB reads the tables of a schema stored in a database table
+// by a schema registry instead of as DDL. The query returns one row per
+// table with two columns: the table name and its definition as JSON, e.g.
+// {"columns": [{"name": "id", "type": "int", "other": "NOT NULL"}],
+// "indexes": [...], "constraints": [...]}, or just the array of columns.
+func ParseMetadataDB(db *sql.DB, query string) (map[string]Table, error) {
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := make(map[string]Table)
+	for rows.Next() {
+		var name string
+		var definition []byte
+		if err := rows.Scan(&name, &definition); err != nil {
+			return nil, err
+		}
+		table, err := metadataTableFromJSON(name, definition)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		tables[name] = table
+	}
+	return tables, rows.Err()
+}
+
+func metadataTableFromJSON(name string, definition []byte) (Table, error) {
+
+	var def metadataTable
+	if strings.HasPrefix(strings.TrimSpace(string(definition)), "[") {
+		if err := json.Unmarshal(definition, &def.Columns); err != nil {
+			return Table{}, err
+		}
+	} else if err := json.Unmarshal(definition, &def); err != nil {
+		return Table{}, err
+	}
+
+	table := newTable(name)
+	for _, column := range def.Columns {
+		if column.Name == "" {
+			return Table{}, fmt.Errorf("column without a name")
+		}
+		table.Columns[column.Name] = newColumn(column.Name, column.Type, column.Other, len(table.Columns))
+	}
+	for _, index := range def.Indexes {
+		columnName := metadataColumnName(index.Column, index.Columns)
+		table.Indexes[columnName] = Index{Name: index.Name, ColumnName: columnName, Kind: IndexKind(strings.ToUpper(index.Kind))}
+	}
+	for _, constraint := range def.Constraints {
+		addConstraint(table, Constraint{
+			Name:       constraint.Name,
+			ColumnName: metadataColumnName(constraint.Column, constraint.Columns),
+			Type:       strings.ToUpper(constraint.Type),
+			Other:      constraint.Other,
+		})
+	}
+	return table, nil
+}
+
+// metadataColumnName returns the column names of a key the way the MySQL
+// parser stores them, joined by quoted commas.
+func metadataColumnName(column string, columns []string) string {
+	if len(columns) == 0 {
+		return column
+	}
+	return strings.Join(columns, "`,`")
+}
+
+// readMetadataSchema connects to the MySQL database at dsn and reads the
+// schema stored in it with ParseMetadataDB.
+func readMetadataSchema(dsn string, query string) (map[string]Table, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	return ParseMetadataDB(db, query)
+}
@@ -0,0 +1,504 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type TableAlter struct {
+	Table    string
+	Clauses  []string
+	Comments []string
+}
+
+type Migration struct {
+	CreateTables []Table
+	Alters       []TableAlter
+	DropTables   []string
+}
+
+type PerconaOptions struct {
+	Database      string
+	MaxLoad       string
+	CriticalLoad  string
+	CheckSlaveLag string
+}
+
+type alterClauses struct {
+	comments        []string
+	dropConstraints []string
+	dropIndexes     []string
+	dropColumns     []string
+	addColumns      []string
+	modifyColumns   []string
+	addIndexes      []string
+	renameIndexes   []string
+	addConstraints  []string
+	tableOptions    []string
+}
+
+func (a *alterClauses) all() []string {
+	slices := [][]string{
+		a.dropConstraints,
+		a.dropIndexes,
+		a.dropColumns,
+		a.addColumns,
+		a.modifyColumns,
+		a.addIndexes,
+		a.renameIndexes,
+		a.addConstraints,
+		a.tableOptions,
+	}
+
+	var res []string
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return res
+}
+
+// buildMigration computes the statements that turn schema A into schema B.
+// Objects only present in A are dropped, objects only present in B are
+// created and objects present in both are modified to match B.
+func buildMigration(tablesA map[string]Table, tablesB map[string]Table, opts CompareOptions) Migration {
+
+	var migration Migration
+	alters := make(map[string]*alterClauses)
+	modified := make(map[string]bool)
+	// indexes share the table.column targets of columns, so they are
+	// tracked apart
+	recreatedIndexes := make(map[string]bool)
+
+	alterFor := func(tableName string) *alterClauses {
+		if alters[tableName] == nil {
+			alters[tableName] = &alterClauses{}
+		}
+		return alters[tableName]
+	}
+
+	removed := groupByType(compareTables(tablesA, tablesB, opts))
+	for _, d := range removed {
+
+		switch d.Type {
+		case MissingTable:
+			migration.DropTables = append(migration.DropTables, d.A)
+		case MissingColumn:
+			a := alterFor(d.Target)
+			a.dropColumns = append(a.dropColumns, fmt.Sprintf("DROP COLUMN `%s`", d.A))
+		case WrongColumnType, WrongSetValues, WrongColumnOther, WrongColumnInvisibility, WrongColumnFormat:
+			if modified[d.Target] {
+				continue
+			}
+			modified[d.Target] = true
+
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			column := tablesB[tableName].Columns[columnName]
+			a.modifyColumns = append(a.modifyColumns, "MODIFY COLUMN "+columnDefinition(column))
+		case NullableToNotNullWithoutDefault:
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			a.comments = append(a.comments, fmt.Sprintf(
+				"`%s` becomes NOT NULL without a default and existing NULL values will make this fail: add a DEFAULT before applying NOT NULL, then drop the default after",
+				columnName))
+		case SensitiveColumnChanged:
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			a.comments = append(a.comments, fmt.Sprintf("`%s` holds sensitive data: review this change before applying it", columnName))
+		case MissingIndex:
+			tableName, _ := splitTarget(d.Target)
+			a := alterFor(tableName)
+			a.dropIndexes = append(a.dropIndexes, fmt.Sprintf("DROP INDEX `%s`", d.A))
+		case WrongIndexKind, WrongIndexPrefixLength:
+			if recreatedIndexes[d.Target] {
+				continue
+			}
+			recreatedIndexes[d.Target] = true
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			a.dropIndexes = append(a.dropIndexes, fmt.Sprintf("DROP INDEX `%s`", tablesA[tableName].Indexes[columnName].Name))
+			a.addIndexes = append(a.addIndexes, "ADD "+indexDefinition(tablesB[tableName].Indexes[columnName]))
+		case WrongIndexName:
+			if recreatedIndexes[d.Target] {
+				// the index is recreated under its new name
+				continue
+			}
+			tableName, _ := splitTarget(d.Target)
+			a := alterFor(tableName)
+			a.renameIndexes = append(a.renameIndexes, fmt.Sprintf("RENAME INDEX `%s` TO `%s`", d.A, d.B))
+		case MissingConstraint:
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			constraint := tablesA[tableName].Constraints[columnName][d.A]
+			a.dropConstraints = append(a.dropConstraints, dropConstraintClause(constraint))
+		case WrongTableEncryption:
+			a := alterFor(d.Target)
+			a.comments = append(a.comments, "changing the encryption rebuilds the table")
+			a.tableOptions = append(a.tableOptions, d.B)
+		case WrongTableStatsOption:
+			a := alterFor(d.Target)
+			a.tableOptions = append(a.tableOptions, d.B)
+		case WrongConstraintOther, WrongFKMatchType:
+			if modified[d.Target] {
+				continue
+			}
+			modified[d.Target] = true
+
+			column := d.Target[:strings.LastIndex(d.Target, ".")]
+			constraintType := d.Target[strings.LastIndex(d.Target, ".")+1:]
+			tableName, columnName := splitTarget(column)
+			a := alterFor(tableName)
+			constraintA := tablesA[tableName].Constraints[columnName][constraintType]
+			constraintB := tablesB[tableName].Constraints[columnName][constraintType]
+			a.dropConstraints = append(a.dropConstraints, dropConstraintClause(constraintA))
+			a.addConstraints = append(a.addConstraints, "ADD "+constraintDefinition(constraintB))
+		}
+	}
+
+	added := groupByType(compareTables(tablesB, tablesA, opts))
+	for _, d := range added {
+
+		switch d.Type {
+		case MissingTable:
+			migration.CreateTables = append(migration.CreateTables, tablesB[d.A])
+		case MissingColumn:
+			a := alterFor(d.Target)
+			column := tablesB[d.Target].Columns[d.A]
+			a.addColumns = append(a.addColumns, "ADD COLUMN "+columnDefinition(column))
+		case MissingIndex:
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			index := tablesB[tableName].Indexes[columnName]
+			a.addIndexes = append(a.addIndexes, "ADD "+indexDefinition(index))
+		case MissingConstraint:
+			tableName, columnName := splitTarget(d.Target)
+			a := alterFor(tableName)
+			constraint := tablesB[tableName].Constraints[columnName][d.A]
+			a.addConstraints = append(a.addConstraints, "ADD "+constraintDefinition(constraint))
+		}
+	}
+
+	// referenced tables are created and altered before the tables whose
+	// foreign keys point at them, and dropped after them
+	for _, tableName := range sortTablesByDependency(tablesB) {
+		if alters[tableName] != nil {
+			migration.Alters = append(migration.Alters, TableAlter{
+				Table:    tableName,
+				Clauses:  alters[tableName].all(),
+				Comments: alters[tableName].comments,
+			})
+		}
+	}
+
+	rankB := dependencyRanks(tablesB)
+	sort.SliceStable(migration.CreateTables, func(i, j int) bool {
+		return rankB[migration.CreateTables[i].Name] < rankB[migration.CreateTables[j].Name]
+	})
+	rankA := dependencyRanks(tablesA)
+	sort.SliceStable(migration.DropTables, func(i, j int) bool {
+		return rankA[migration.DropTables[i]] > rankA[migration.DropTables[j]]
+	})
+
+	return migration
+}
+
+// sortTablesByDependency returns the table names ordered so every table comes
+// after the tables its foreign keys reference, alphabetically otherwise.
+// Tables in a reference cycle are left in alphabetical order at the end.
+func sortTablesByDependency(tables map[string]Table) []string {
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dependencies := make(map[string]map[string]bool)
+	for _, name := range names {
+		dependencies[name] = make(map[string]bool)
+		for _, constraint := range tables[name].SortedConstraints() {
+			referenced := referencedTable(constraint)
+			if _, exists := tables[referenced]; exists && constraint.Type == "FOREIGN" && referenced != name {
+				dependencies[name][referenced] = true
+			}
+		}
+	}
+
+	var sorted []string
+	done := make(map[string]bool)
+	for len(sorted) < len(names) {
+		progress := false
+		for _, name := range names {
+			if done[name] {
+				continue
+			}
+			ready := true
+			for referenced := range dependencies[name] {
+				if !done[referenced] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				sorted = append(sorted, name)
+				done[name] = true
+				progress = true
+			}
+		}
+
+		if !progress {
+			for _, name := range names {
+				if !done[name] {
+					sorted = append(sorted, name)
+					done[name] = true
+				}
+			}
+		}
+	}
+	return sorted
+}
+
+func dependencyRanks(tables map[string]Table) map[string]int {
+	ranks := make(map[string]int)
+	for i, name := range sortTablesByDependency(tables) {
+		ranks[name] = i
+	}
+	return ranks
+}
+
+// referencedTable returns the table a foreign key references, without quotes.
+func referencedTable(constraint Constraint) string {
+	i := findWord(constraint.Other, "REFERENCES")
+	if i < 0 {
+		return ""
+	}
+	rest := strings.TrimSpace(constraint.Other[i+len("REFERENCES"):])
+	return unquoteIdentifier(strings.TrimSpace(strings.SplitN(rest, "(", 2)[0]))
+}
+
+func splitTarget(target string) (string, string) {
+	parts := strings.SplitN(target, ".", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func quoteColumns(columnNames string) string {
+	names := strings.Split(columnNames, ",")
+	for i, name := range names {
+		names[i] = "`" + strings.Trim(strings.TrimSpace(name), "`") + "`"
+	}
+	return "(" + strings.Join(names, ",") + ")"
+}
+
+func columnDefinition(column Column) string {
+	def := fmt.Sprintf("`%s` %s", column.Name, column.Type)
+	if column.Other != "" {
+		def += " " + column.Other
+	}
+	if column.Invisible {
+		def += " INVISIBLE"
+	}
+	if column.ColumnFormat != "" {
+		def += " COLUMN_FORMAT " + column.ColumnFormat
+	}
+	return def
+}
+
+func indexDefinition(index Index) string {
+	if index.IsExpression {
+		return fmt.Sprintf("KEY `%s` (%s)", index.Name, index.Expression)
+	}
+	columns := quoteColumns(index.ColumnName)
+	if index.PrefixLength > 0 {
+		columns = fmt.Sprintf("%s(%d))", strings.TrimSuffix(columns, ")"), index.PrefixLength)
+	}
+	if index.Kind != "" {
+		return fmt.Sprintf("%s KEY `%s` %s", index.Kind, index.Name, columns)
+	}
+	return fmt.Sprintf("KEY `%s` %s", index.Name, columns)
+}
+
+func constraintDefinition(constraint Constraint) string {
+
+	var def string
+	switch constraint.Type {
+	case "PRIMARY":
+		def = "PRIMARY KEY " + quoteColumns(constraint.ColumnName)
+	case "UNIQUE":
+		def = fmt.Sprintf("UNIQUE KEY `%s` %s", constraint.Name, quoteColumns(constraint.ColumnName))
+	case "FOREIGN":
+		def = fmt.Sprintf("CONSTRAINT `%s` FOREIGN KEY %s", constraint.Name, quoteColumns(constraint.ColumnName))
+	default:
+		def = fmt.Sprintf("CONSTRAINT `%s` %s %s", constraint.Name, constraint.Type, quoteColumns(constraint.ColumnName))
+	}
+
+	if constraint.Other != "" {
+		def += " " + constraint.Other
+	}
+	if constraint.MatchType != "" {
+		def = withMatchType(def, constraint.MatchType)
+	}
+	return def
+}
+
+// withMatchType puts the MATCH clause back right after the referenced columns,
+// where MySQL expects it.
+func withMatchType(def string, matchType string) string {
+	i := findWord(def, "REFERENCES")
+	if i < 0 {
+		return def + " MATCH " + matchType
+	}
+	open := strings.IndexByte(def[i:], '(')
+	if open < 0 {
+		return def + " MATCH " + matchType
+	}
+	end := matchingParen(def, i+open)
+	if end < 0 {
+		return def + " MATCH " + matchType
+	}
+	return def[:end+1] + " MATCH " + matchType + def[end+1:]
+}
+
+func dropConstraintClause(constraint Constraint) string {
+	switch constraint.Type {
+	case "PRIMARY":
+		return "DROP PRIMARY KEY"
+	case "UNIQUE":
+		return fmt.Sprintf("DROP INDEX `%s`", constraint.Name)
+	case "FOREIGN":
+		return fmt.Sprintf("DROP FOREIGN KEY `%s`", constraint.Name)
+	}
+	return fmt.Sprintf("DROP CONSTRAINT `%s`", constraint.Name)
+}
+
+// noOpMigration is the migration -emit-no-op-migration prints for identical
+// schemas, valid SQL that changes nothing.
+const noOpMigration = "SELECT 'No migration needed';\n"
+
+// generateRollback returns the migration undoing the one from tablesA to
+// tablesB: added columns and tables are dropped, dropped ones are created
+// again from their definition in tablesA and modified columns get their
+// original definition back. It needs both schemas rather than the diffs of
+// the forward migration, which only name the dropped tables, columns,
+// indexes and constraints.
+func generateRollback(tablesA map[string]Table, tablesB map[string]Table, opts CompareOptions) Migration {
+	return buildMigration(tablesB, tablesA, opts)
+}
+
+func createTableSQL(table Table) string {
+
+	columns := table.SortedColumns()
+	indexes := table.SortedIndexes()
+	constraints := table.SortedConstraints()
+
+	var defs []string
+	for _, column := range columns {
+		defs = append(defs, columnDefinition(column))
+	}
+	// keys come before the indexes and the foreign keys after them
+	isKey := func(c Constraint) bool {
+		return c.Type == "PRIMARY" || c.Type == "UNIQUE"
+	}
+	for _, constraint := range constraints {
+		if isKey(constraint) {
+			defs = append(defs, constraintDefinition(constraint))
+		}
+	}
+	for _, index := range indexes {
+		defs = append(defs, indexDefinition(index))
+	}
+	for _, constraint := range constraints {
+		if !isKey(constraint) {
+			defs = append(defs, constraintDefinition(constraint))
+		}
+	}
+
+	options := tableOptionsClause(table.Options)
+	if options != "" {
+		options = " " + options
+	}
+	return fmt.Sprintf("CREATE TABLE `%s` (\n  %s\n)%s;", table.Name, strings.Join(defs, ",\n  "), options)
+}
+
+func alterTableSQL(alter TableAlter) string {
+	return commentLines("-- ", alter.Comments) +
+		fmt.Sprintf("ALTER TABLE `%s`\n  %s;", alter.Table, strings.Join(alter.Clauses, ",\n  "))
+}
+
+func commentLines(prefix string, comments []string) string {
+	var b strings.Builder
+	for _, comment := range comments {
+		b.WriteString(prefix + comment + "\n")
+	}
+	return b.String()
+}
+
+func renderMigrationSQL(migration Migration) string {
+	return joinStatements(migrationStatements(migration))
+}
+
+func migrationStatements(migration Migration) []string {
+
+	var statements []string
+	for _, table := range migration.CreateTables {
+		statements = append(statements, createTableSQL(table))
+	}
+	for _, alter := range migration.Alters {
+		statements = append(statements, alterTableSQL(alter))
+	}
+	for _, tableName := range migration.DropTables {
+		statements = append(statements, fmt.Sprintf("DROP TABLE `%s`;", tableName))
+	}
+
+	return statements
+}
+
+// renderPerconaMigration emits one pt-online-schema-change command per
+// altered table. pt-osc only handles ALTER TABLE, so created and dropped
+// tables are still emitted as plain SQL.
+func renderPerconaMigration(migration Migration, opts PerconaOptions) string {
+
+	var statements []string
+	for _, table := range migration.CreateTables {
+		statements = append(statements, createTableSQL(table))
+	}
+	for _, alter := range migration.Alters {
+		statements = append(statements, perconaCommand(alter, opts))
+	}
+	for _, tableName := range migration.DropTables {
+		statements = append(statements, fmt.Sprintf("DROP TABLE `%s`;", tableName))
+	}
+
+	return joinStatements(statements)
+}
+
+func perconaCommand(alter TableAlter, opts PerconaOptions) string {
+
+	args := []string{"pt-online-schema-change", "--alter", shellQuote(strings.Join(alter.Clauses, ", "))}
+	if opts.MaxLoad != "" {
+		args = append(args, "--max-load", shellQuote(opts.MaxLoad))
+	}
+	if opts.CriticalLoad != "" {
+		args = append(args, "--critical-load", shellQuote(opts.CriticalLoad))
+	}
+	if opts.CheckSlaveLag != "" {
+		args = append(args, "--check-slave-lag", shellQuote(opts.CheckSlaveLag))
+	}
+	args = append(args, fmt.Sprintf("D=%s,t=%s", opts.Database, alter.Table), "--execute")
+
+	return commentLines("# ", alter.Comments) + strings.Join(args, " ")
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func joinStatements(statements []string) string {
+	if len(statements) == 0 {
+		return ""
+	}
+	return strings.Join(statements, "\n\n") + "\n"
+}
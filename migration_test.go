@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrationModifiesColumnAndRenamesIndex(t *testing.T) {
+	tablesA := mustParseTables(t, "CREATE TABLE `users` (\n  `id` int NOT NULL,\n  `email` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `uk_email` (`email`)\n) ENGINE=InnoDB;\n")
+	tablesB := mustParseTables(t, "CREATE TABLE `users` (\n  `id` int NOT NULL,\n  `email` varchar(255) NOT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `uk_email2` (`email`)\n) ENGINE=InnoDB;\n")
+
+	migration := buildMigration(tablesA, tablesB, CompareOptions{})
+	sql := renderMigrationSQL(migration)
+	for _, want := range []string{"MODIFY COLUMN `email` varchar(255) NOT NULL", "RENAME INDEX `uk_email` TO `uk_email2`"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("migration does not contain %q:\n%s", want, sql)
+		}
+	}
+
+	applied, err := applyMigration(tablesA, migration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	residual := append(compareTables(applied, tablesB, CompareOptions{}), compareTables(tablesB, applied, CompareOptions{})...)
+	if len(residual) > 0 {
+		t.Errorf("diffs left after applying the migration: %+v", residual)
+	}
+}
+
+func TestMigrationCreatesTableWithOptions(t *testing.T) {
+	tablesA := mustParseTables(t, "")
+	tablesB := mustParseTables(t, "CREATE TABLE `orders` (\n  `id` int NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n"+
+		") ENGINE=InnoDB AUTO_INCREMENT=42 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='customer orders' STATS_PERSISTENT=1;\n")
+
+	sql := renderMigrationSQL(buildMigration(tablesA, tablesB, CompareOptions{}))
+	want := ") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='customer orders' STATS_PERSISTENT=1;"
+	if !strings.Contains(sql, want) {
+		t.Errorf("migration does not contain %q:\n%s", want, sql)
+	}
+}
+
+func TestRollbackRestoresFirstSchema(t *testing.T) {
+	tablesA := mustParseTables(t, "CREATE TABLE `users` (\n  `id` int NOT NULL,\n  `email` varchar(255) NOT NULL,\n  `name` varchar(100) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  KEY `idx_name` (`name`)\n) ENGINE=InnoDB;\n"+
+		"CREATE TABLE `audit` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n")
+	tablesB := mustParseTables(t, "CREATE TABLE `users` (\n  `id` int NOT NULL,\n  `email` varchar(320) NOT NULL,\n  `created_at` datetime DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB;\n")
+
+	rollback := generateRollback(tablesA, tablesB, CompareOptions{})
+	sql := renderMigrationSQL(rollback)
+	for _, want := range []string{"DROP COLUMN `created_at`", "MODIFY COLUMN `email` varchar(255) NOT NULL", "ADD COLUMN `name` varchar(100) DEFAULT NULL", "CREATE TABLE `audit`"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("rollback does not contain %q:\n%s", want, sql)
+		}
+	}
+
+	applied, err := applyMigration(tablesB, rollback)
+	if err != nil {
+		t.Fatal(err)
+	}
+	residual := append(compareTables(applied, tablesA, CompareOptions{}), compareTables(tablesA, applied, CompareOptions{})...)
+	if len(residual) > 0 {
+		t.Errorf("diffs left after applying the rollback: %+v", residual)
+	}
+}
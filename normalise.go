@@ -0,0 +1,141 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+type columnAttribute struct {
+	words []string
+	// number of value tokens after the words
+	values int
+	rank   int
+}
+
+// the column attributes in the order SHOW CREATE TABLE writes them
+var columnAttributes = []columnAttribute{
+	{words: []string{"UNSIGNED"}, rank: 0},
+	{words: []string{"ZEROFILL"}, rank: 1},
+	{words: []string{"CHARACTER", "SET"}, values: 1, rank: 2},
+	{words: []string{"CHARSET"}, values: 1, rank: 2},
+	{words: []string{"COLLATE"}, values: 1, rank: 3},
+	{words: []string{"GENERATED", "ALWAYS", "AS"}, values: 1, rank: 4},
+	{words: []string{"AS"}, values: 1, rank: 4},
+	{words: []string{"NOT", "NULL"}, rank: 5},
+	{words: []string{"NULL"}, rank: 5},
+	{words: []string{"DEFAULT"}, values: 1, rank: 6},
+	{words: []string{"ON", "UPDATE"}, values: 1, rank: 7},
+	{words: []string{"AUTO_INCREMENT"}, rank: 8},
+	{words: []string{"UNIQUE", "KEY"}, rank: 9},
+	{words: []string{"UNIQUE"}, rank: 9},
+	{words: []string{"PRIMARY", "KEY"}, rank: 9},
+	{words: []string{"COMMENT"}, values: 1, rank: 10},
+}
+
+// attributes that are not recognised keep their place after the known ones
+const unknownAttributeRank = 11
+
+// normaliseColumnDef rewrites a column definition without its name, the
+// type followed by its attributes, in a canonical form: whitespace
+// collapsed, the type in lower case, keywords in upper case and the
+// attributes in the order SHOW CREATE TABLE uses. Quoted values are kept as
+// written.
+func normaliseColumnDef(def string) string {
+
+	var tokens []string
+	for _, token := range splitTopLevel(strings.Join(strings.Fields(def), " "), ' ') {
+		if token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	type attribute struct {
+		text string
+		rank int
+	}
+	var attributes []attribute
+
+	for i := 1; i < len(tokens); {
+		known := false
+		for _, a := range columnAttributes {
+			if !hasPrefixWords(tokens[i:], a.words...) || len(tokens) < i+len(a.words)+a.values {
+				continue
+			}
+
+			words := a.words
+			if words[0] == "CHARSET" {
+				words = []string{"CHARACTER", "SET"}
+			}
+			parts := append([]string{}, words...)
+			i += len(a.words)
+			for v := 0; v < a.values; v++ {
+				parts = append(parts, normaliseValue(a.words[0], tokens[i]))
+				i++
+			}
+			// generated columns end with VIRTUAL or STORED
+			if a.words[len(a.words)-1] == "AS" && i < len(tokens) && (hasPrefixWords(tokens[i:], "VIRTUAL") || hasPrefixWords(tokens[i:], "STORED")) {
+				parts = append(parts, strings.ToUpper(tokens[i]))
+				i++
+			}
+
+			attributes = append(attributes, attribute{text: strings.Join(parts, " "), rank: a.rank})
+			known = true
+			break
+		}
+		if !known {
+			attributes = append(attributes, attribute{text: tokens[i], rank: unknownAttributeRank})
+			i++
+		}
+	}
+
+	sort.SliceStable(attributes, func(i, j int) bool {
+		return attributes[i].rank < attributes[j].rank
+	})
+
+	res := []string{normaliseType(tokens[0])}
+	for _, a := range attributes {
+		res = append(res, a.text)
+	}
+	return strings.Join(res, " ")
+}
+
+// normaliseType lower-cases a column type, leaving the quoted ENUM and SET
+// values as written.
+func normaliseType(columnType string) string {
+	open := strings.IndexByte(columnType, '(')
+	if open < 0 {
+		return strings.ToLower(columnType)
+	}
+	return strings.ToLower(columnType[:open]) + normalizeExpression(columnType[open:])
+}
+
+func normaliseValue(keyword string, value string) string {
+	switch {
+	case strings.HasPrefix(value, "'") || strings.HasPrefix(value, "\""):
+		return value
+	case strings.HasPrefix(value, "("):
+		return normalizeExpression(value)
+	case keyword == "CHARACTER" || keyword == "CHARSET" || keyword == "COLLATE":
+		return strings.ToLower(value)
+	}
+	return strings.ToUpper(value)
+}
+
+// normaliseTables rewrites every column of the tables with
+// normaliseColumnDef, so formatting differences do not show up as diffs.
+func normaliseTables(tables map[string]Table) {
+	for _, table := range tables {
+		for name, column := range table.Columns {
+			def := normaliseColumnDef(column.Type + " " + column.Other)
+			columnType := leadingValue(def)
+			other := strings.TrimSpace(def[len(columnType):])
+			normalised := newColumn(name, columnType, other, column.Ordinal)
+			normalised.Invisible = column.Invisible
+			normalised.ColumnFormat = column.ColumnFormat
+			table.Columns[name] = normalised
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// A comparison runs through a pipeline of stages: parse, normalize, filter,
+// compare and format. Each stage is an interface, so a custom one can
+// replace the built-in stage, e.g. a parser for a proprietary dialect
+// feeding the built-in comparison and output formats.
+
+// ParseStage turns the contents of a schema file, or of a database laid out
+// like one, into a Schema. The definitions it skipped are returned
+// alongside, for the text output to warn about.
+type ParseStage interface {
+	Parse(r io.Reader) (Schema, []ParseError, error)
+}
+
+// NormalizeStage rewrites a parsed schema so formatting differences do not
+// show up as diffs.
+type NormalizeStage interface {
+	Normalize(schema Schema) Schema
+}
+
+// FilterStage keeps the parts of a schema that are compared.
+type FilterStage interface {
+	Filter(schema Schema) Schema
+}
+
+// CompareStage returns the diffs between the old schema a and the new
+// schema b.
+type CompareStage interface {
+	Compare(a Schema, b Schema) []Diff
+}
+
+// FormatStage writes the report of a comparison.
+type FormatStage interface {
+	Format(w io.Writer, report Report) error
+}
+
+// Report is what the format stage writes: the diffs, with the schemas as
+// parsed and what was found while parsing and linting them.
+type Report struct {
+	PathA        string
+	PathB        string
+	SchemaA      Schema
+	SchemaB      Schema
+	Diffs        []Diff
+	LintA        []LintWarning
+	LintB        []LintWarning
+	ParseErrorsA []ParseError
+	ParseErrorsB []ParseError
+}
+
+// Pipeline holds the stages of a comparison. A nil stage is replaced with
+// the built-in one for the run options.
+type Pipeline struct {
+	Parse     ParseStage
+	Normalize NormalizeStage
+	Filter    FilterStage
+	Compare   CompareStage
+	Format    FormatStage
+}
+
+// defaultPipeline returns the built-in stages, configured by the flags.
+func defaultPipeline(opts runOptions) Pipeline {
+	return Pipeline{
+		Parse:     dialectParser{opts: opts},
+		Normalize: normaliser{enabled: opts.Normalise},
+		Filter:    schemaFilter{opts: opts.Compare},
+		Compare:   schemaComparer{opts: opts},
+		Format:    formatter{opts: opts},
+	}
+}
+
+// pipeline returns the pipeline of the run options, with the built-in stage
+// in place of every stage left nil.
+func (opts runOptions) pipeline() Pipeline {
+	p := opts.Pipeline
+	defaults := defaultPipeline(opts)
+	if p.Parse == nil {
+		p.Parse = defaults.Parse
+	}
+	if p.Normalize == nil {
+		p.Normalize = defaults.Normalize
+	}
+	if p.Filter == nil {
+		p.Filter = defaults.Filter
+	}
+	if p.Compare == nil {
+		p.Compare = defaults.Compare
+	}
+	if p.Format == nil {
+		p.Format = defaults.Format
+	}
+	return p
+}
+
+// dialectParser parses the dialect selected with -dialect, with the views,
+// triggers and routines of MySQL schemas and the sequences of PostgreSQL
+// ones.
+type dialectParser struct {
+	opts runOptions
+}
+
+// MySQL dumps are parsed as they are read, the other dialects once read
+// whole.
+func (p dialectParser) Parse(r io.Reader) (Schema, []ParseError, error) {
+
+	if p.opts.Dialect == "mysql" {
+		return parseMySQLSchema(r, p.opts.Strict, p.opts.SchemaPrefix, p.opts.Alembic)
+	}
+
+	bytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Schema{}, nil, err
+	}
+	data := string(bytes)
+	tables, err := parseSchema(data, p.opts)
+	if err != nil {
+		return Schema{}, nil, err
+	}
+
+	schema := Schema{Tables: tables}
+	switch p.opts.Dialect {
+	case "postgres", "cockroachdb":
+		schema.Sequences = parsePostgresSequences(data)
+		addImplicitSequences(schema.Sequences, tables)
+	}
+	return schema, nil, nil
+}
+
+// normaliser rewrites the column definitions with -normalise.
+type normaliser struct {
+	enabled bool
+}
+
+func (n normaliser) Normalize(schema Schema) Schema {
+	if n.enabled {
+		normaliseTables(schema.Tables)
+	}
+	return schema
+}
+
+// schemaFilter keeps the tables, and the views and triggers named after
+// them, matching -include-tables and -exclude-tables, and drops the
+// triggers with -no-triggers.
+type schemaFilter struct {
+	opts CompareOptions
+}
+
+func (f schemaFilter) Filter(schema Schema) Schema {
+
+	filtered := schema
+	filtered.Tables = filterTables(schema.Tables, f.opts)
+
+	filtered.Views = make(map[string]View)
+	for name, view := range schema.Views {
+		if includesTable(name, f.opts) {
+			filtered.Views[name] = view
+		}
+	}
+
+	filtered.Triggers = make(map[string]Trigger)
+	for name, trigger := range schema.Triggers {
+		if !f.opts.NoTriggers && includesTable(trigger.Table, f.opts) {
+			filtered.Triggers[name] = trigger
+		}
+	}
+	return filtered
+}
+
+// schemaComparer compares everything in the schemas, or only looks for
+// additions with -additive-only, or only the parts of the tables selected
+// with -diff-only-*, and detects renames with -detect-renames.
+// The diffs are grouped by type.
+type schemaComparer struct {
+	opts runOptions
+}
+
+func (c schemaComparer) Compare(a Schema, b Schema) []Diff {
+
+	diffs := compareTables(a.Tables, b.Tables, c.opts.Compare)
+	if c.opts.AdditiveOnly {
+		diffs = compareAdditions(a.Tables, b.Tables, c.opts.Compare)
+	} else if c.opts.Compare.comparesPart("") {
+		diffs = append(diffs, compareViews(a.Views, b.Views, c.opts.Compare)...)
+		diffs = append(diffs, compareTriggers(a.Triggers, b.Triggers, c.opts.Compare)...)
+		diffs = append(diffs, compareRoutines(a.Routines, b.Routines)...)
+		diffs = append(diffs, compareSequences(a.Sequences, b.Sequences)...)
+	}
+	if c.opts.DetectRenames {
+		diffs = detectTableRenames(diffs, a.Tables, b.Tables, c.opts.RenameThreshold)
+		diffs = detectColumnRenames(diffs, a.Tables, b.Tables)
+	}
+	return groupByType(diffs)
+}
+
+// formatter writes the report in the -format output format.
+type formatter struct {
+	opts runOptions
+}
+
+func (f formatter) Format(w io.Writer, r Report) error {
+
+	var err error
+	switch f.opts.Format {
+	case "text":
+		printDiffs(w, r.Diffs, r.PathA, r.PathB, textOptions{Color: f.opts.Color, GroupBy: f.opts.GroupBy})
+		printLintWarnings(w, r.LintA, r.PathA)
+		printLintWarnings(w, r.LintB, r.PathB)
+		printParseWarnings(w, r.ParseErrorsA, r.PathA)
+		printParseWarnings(w, r.ParseErrorsB, r.PathB)
+	case "side-by-side":
+		printSideBySide(w, r.Diffs, r.PathA, r.PathB, f.opts.Color)
+		printLintWarnings(w, r.LintA, r.PathA)
+		printLintWarnings(w, r.LintB, r.PathB)
+		printParseWarnings(w, r.ParseErrorsA, r.PathA)
+		printParseWarnings(w, r.ParseErrorsB, r.PathB)
+	case "csv":
+		err = writeCSV(w, r.Diffs)
+	case "html":
+		_, err = fmt.Fprint(w, renderHTML(r.Diffs, r.PathA, r.PathB))
+	case "markdown":
+		_, err = fmt.Fprint(w, renderMarkdown(r.Diffs, r.PathA, r.PathB))
+	case "jira":
+		_, err = fmt.Fprint(w, renderJira(r.Diffs, r.PathA, r.PathB))
+	case "ascii":
+		_, err = fmt.Fprint(w, renderASCII(r.SchemaA.Tables, r.SchemaB.Tables, f.opts.Compare))
+	case "dot":
+		_, err = fmt.Fprint(w, renderDot(filterTables(r.SchemaA.Tables, f.opts.Compare), filterTables(r.SchemaB.Tables, f.opts.Compare)))
+	case "git-diff":
+		_, err = fmt.Fprint(w, renderUnifiedDiff(filterTables(r.SchemaA.Tables, f.opts.Compare), filterTables(r.SchemaB.Tables, f.opts.Compare)))
+	case "terraform":
+		_, err = fmt.Fprint(w, renderTerraform(r.Diffs, r.SchemaA.Tables, r.SchemaB.Tables, f.opts.Compare, f.opts.Color))
+	case "json":
+		err = writeJSON(w, r.Diffs, r.PathA, r.PathB, map[string][]LintWarning{r.PathA: r.LintA, r.PathB: r.LintB})
+	default:
+		err = fmt.Errorf("unknown format: %s", f.opts.Format)
+	}
+	return err
+}
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// multi-word PostgreSQL types, as written by pg_dump
+var postgresMultiWordTypes = []string{
+	"character varying",
+	"bit varying",
+	"double precision",
+	"timestamp without time zone",
+	"timestamp with time zone",
+	"time without time zone",
+	"time with time zone",
+}
+
+var postgresSerialTypes = map[string]string{
+	"smallserial": "smallint",
+	"serial":      "integer",
+	"bigserial":   "bigint",
+	"serial2":     "smallint",
+	"serial4":     "integer",
+	"serial8":     "bigint",
+}
+
+// ParsePostgresDump parses the output of pg_dump. Schema prefixes are
+// stripped from table names, SERIAL columns and nextval/identity defaults
+// are stored as AUTO_INCREMENT, and inline REFERENCES, separate ALTER TABLE
+// ... ADD CONSTRAINT and CREATE INDEX statements are attached to their table.
+func ParsePostgresDump(data string) (map[string]Table, error) {
+
+	tables := make(map[string]Table)
+
+	for _, statement := range splitStatements(data) {
+		words := strings.Fields(statement)
+
+		switch {
+		case hasPrefixWords(words, "CREATE", "TABLE"),
+			hasPrefixWords(words, "CREATE", "UNLOGGED", "TABLE"):
+			table, err := parsePostgresCreateTable(statement)
+			if err != nil {
+				return nil, err
+			}
+			if table.Name != "" {
+				tables[table.Name] = table
+			}
+		case hasPrefixWords(words, "ALTER", "TABLE"):
+			parsePostgresAlterTable(words, statement, tables)
+		case hasPrefixWords(words, "CREATE", "INDEX"),
+			hasPrefixWords(words, "CREATE", "UNIQUE", "INDEX"):
+			parsePostgresCreateIndex(words, statement, tables)
+		}
+	}
+
+	return tables, nil
+}
+
+func newTable(name string) Table {
+	return Table{
+		Name:        name,
+		Columns:     make(map[string]Column),
+		Indexes:     make(map[string]Index),
+		Constraints: make(map[string](map[string]Constraint)),
+	}
+}
+
+func addConstraint(table Table, constraint Constraint) {
+	if constraint.Type == "FOREIGN" {
+		constraint.Other, constraint.MatchType = splitMatchType(constraint.Other)
+	}
+	if table.Constraints[constraint.ColumnName] == nil {
+		table.Constraints[constraint.ColumnName] = make(map[string]Constraint)
+	}
+	table.Constraints[constraint.ColumnName][constraint.Type] = constraint
+}
+
+func parsePostgresCreateTable(statement string) (Table, error) {
+
+	open := strings.IndexByte(statement, '(')
+	if open < 0 || hasNoColumnList(statement[:open]) {
+		// CREATE TABLE ... AS SELECT or PARTITION OF
+		return Table{}, nil
+	}
+	end := matchingParen(statement, open)
+	if end < 0 {
+		return Table{}, fmt.Errorf("unbalanced parentheses in: %s", firstLine(statement))
+	}
+
+	header := strings.Fields(statement[:open])
+	table := newTable(unquoteIdentifier(header[len(header)-1]))
+
+	for _, item := range splitTopLevel(statement[open+1:end], ',') {
+		words := strings.Fields(item)
+		if len(words) == 0 {
+			continue
+		}
+
+		switch {
+		case hasPrefixWords(words, "CONSTRAINT"):
+			if len(words) < 3 {
+				return Table{}, fmt.Errorf("malformed constraint in table %s: %s", table.Name, item)
+			}
+			name := unquoteIdentifier(words[1])
+			rest := strings.TrimSpace(item[strings.Index(item, words[1])+len(words[1]):])
+			parsePostgresTableConstraint(table, name, rest)
+		case hasPrefixWords(words, "PRIMARY", "KEY"),
+			hasPrefixWords(words, "UNIQUE"),
+			hasPrefixWords(words, "FOREIGN", "KEY"),
+			hasPrefixWords(words, "CHECK"),
+			hasPrefixWords(words, "EXCLUDE"),
+			hasPrefixWords(words, "LIKE"):
+			parsePostgresTableConstraint(table, "", item)
+		default:
+			if len(words) < 2 {
+				return Table{}, fmt.Errorf("malformed column in table %s: %s", table.Name, item)
+			}
+			parsePostgresColumn(table, item)
+		}
+	}
+
+	return table, nil
+}
+
+// hasNoColumnList reports whether the header of a CREATE TABLE, up to its
+// first parenthesis, is one of CREATE TABLE ... AS SELECT or PARTITION OF,
+// whose parentheses are not a column list.
+func hasNoColumnList(header string) bool {
+	words := strings.Fields(header)
+	for i, word := range words {
+		if strings.EqualFold(word, "AS") || strings.EqualFold(word, "PARTITION") && i+1 < len(words) && strings.EqualFold(words[i+1], "OF") {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePostgresColumn(table Table, def string) {
+
+	words := strings.Fields(def)
+	name := unquoteIdentifier(words[0])
+
+	typeWords := 1
+	for _, multiWord := range postgresMultiWordTypes {
+		candidate := strings.Fields(multiWord)
+		if len(words)-1 < len(candidate) {
+			continue
+		}
+
+		matches := true
+		for i, word := range candidate {
+			if strings.ToLower(stripTypeLength(words[1+i])) != word {
+				matches = false
+				break
+			}
+		}
+		if matches && len(candidate) > typeWords {
+			typeWords = len(candidate)
+		}
+	}
+
+	columnType := strings.Join(words[1:1+typeWords], " ")
+	rest := words[1+typeWords:]
+
+	autoIncrement := false
+	if baseType, isSerial := postgresSerialTypes[strings.ToLower(columnType)]; isSerial {
+		columnType = baseType
+		autoIncrement = true
+	}
+
+	var other []string
+	constraintName := ""
+	for i := 0; i < len(rest); i++ {
+		word := strings.ToUpper(rest[i])
+
+		switch {
+		case word == "CONSTRAINT" && i+1 < len(rest):
+			constraintName = unquoteIdentifier(rest[i+1])
+			i++
+		case word == "PRIMARY" && i+1 < len(rest) && strings.ToUpper(rest[i+1]) == "KEY":
+			addConstraint(table, Constraint{
+				Name:       nameOr(constraintName, table.Name+"_pkey"),
+				ColumnName: name,
+				Type:       "PRIMARY",
+			})
+			constraintName = ""
+			i++
+		case word == "UNIQUE":
+			addConstraint(table, Constraint{
+				Name:       nameOr(constraintName, fmt.Sprintf("%s_%s_key", table.Name, name)),
+				ColumnName: name,
+				Type:       "UNIQUE",
+			})
+			constraintName = ""
+		case word == "REFERENCES":
+			n := referencesLength(rest[i:])
+			references, _ := normalizeReferences(strings.Join(rest[i:i+n], " "))
+			addConstraint(table, Constraint{
+				Name:       nameOr(constraintName, fmt.Sprintf("%s_%s_fkey", table.Name, name)),
+				ColumnName: name,
+				Type:       "FOREIGN",
+				Other:      references,
+			})
+			constraintName = ""
+			i += n - 1
+		case word == "DEFAULT" && i+1 < len(rest) && strings.HasPrefix(strings.ToLower(rest[i+1]), "nextval("):
+			autoIncrement = true
+			i++
+		default:
+			other = append(other, rest[i])
+		}
+	}
+
+	columnOther := strings.Join(other, " ")
+	if autoIncrement {
+		columnOther = autoIncrementOther(columnOther)
+	}
+
+	table.Columns[name] = newColumn(name, columnType, columnOther, len(table.Columns))
+}
+
+// referencesLength returns how many words of a column definition belong to
+// the REFERENCES clause starting at words[0].
+func referencesLength(words []string) int {
+
+	n := 2
+	if n > len(words) {
+		return len(words)
+	}
+	if !strings.Contains(words[1], "(") && n < len(words) && strings.HasPrefix(words[n], "(") {
+		n++
+	}
+
+	for n < len(words) {
+		word := strings.ToUpper(words[n])
+
+		switch {
+		case word == "ON" && n+2 < len(words):
+			action := strings.ToUpper(words[n+2])
+			if action == "SET" || action == "NO" {
+				n += 4
+			} else {
+				n += 3
+			}
+		case word == "MATCH" && n+1 < len(words):
+			n += 2
+		case word == "DEFERRABLE":
+			n++
+		case word == "NOT" && n+1 < len(words) && strings.ToUpper(words[n+1]) == "DEFERRABLE":
+			n += 2
+		case word == "INITIALLY" && n+1 < len(words):
+			n += 2
+		default:
+			return n
+		}
+	}
+
+	if n > len(words) {
+		return len(words)
+	}
+	return n
+}
+
+// normalizeReferences rewrites "REFERENCES public.users(id)" as
+// "REFERENCES users (id)" so inline and table level foreign keys match. It
+// returns false when references does not start with REFERENCES.
+func normalizeReferences(references string) (string, bool) {
+
+	if !strings.HasPrefix(strings.ToUpper(references), "REFERENCES") {
+		return "", false
+	}
+	rest := strings.TrimSpace(references[len("REFERENCES"):])
+	open := strings.IndexByte(rest, '(')
+	if open < 0 {
+		return "REFERENCES " + unquoteIdentifier(rest), true
+	}
+
+	refTable := unquoteIdentifier(strings.TrimSpace(rest[:open]))
+	columns, tail := parenList(rest)
+
+	res := fmt.Sprintf("REFERENCES %s (%s)", refTable, strings.Join(columns, ","))
+	if tail != "" {
+		res += " " + tail
+	}
+	return res, true
+}
+
+func parsePostgresTableConstraint(table Table, name string, def string) {
+
+	words := strings.Fields(def)
+
+	switch {
+	case hasPrefixWords(words, "PRIMARY", "KEY"):
+		columns, _ := parenList(def)
+		addConstraint(table, Constraint{
+			Name:       nameOr(name, table.Name+"_pkey"),
+			ColumnName: joinColumnNames(columns),
+			Type:       "PRIMARY",
+		})
+	case hasPrefixWords(words, "UNIQUE"):
+		columns, _ := parenList(def)
+		addConstraint(table, Constraint{
+			Name:       nameOr(name, fmt.Sprintf("%s_%s_key", table.Name, strings.Join(columns, "_"))),
+			ColumnName: joinColumnNames(columns),
+			Type:       "UNIQUE",
+		})
+	case hasPrefixWords(words, "FOREIGN", "KEY"):
+		columns, rest := parenList(def)
+		// a foreign key without a REFERENCES clause is not valid, skip it
+		references, ok := normalizeReferences(rest)
+		if !ok {
+			return
+		}
+		addConstraint(table, Constraint{
+			Name:       nameOr(name, fmt.Sprintf("%s_%s_fkey", table.Name, strings.Join(columns, "_"))),
+			ColumnName: joinColumnNames(columns),
+			Type:       "FOREIGN",
+			Other:      references,
+		})
+	}
+}
+
+func parsePostgresAlterTable(words []string, statement string, tables map[string]Table) {
+
+	i := 2
+	for i < len(words) && (strings.ToUpper(words[i]) == "ONLY" || hasPrefixWords(words[i:], "IF", "EXISTS")) {
+		if strings.ToUpper(words[i]) == "ONLY" {
+			i++
+		} else {
+			i += 2
+		}
+	}
+	if i >= len(words) {
+		return
+	}
+
+	table, exists := tables[unquoteIdentifier(words[i])]
+	if !exists {
+		return
+	}
+	action := words[i+1:]
+
+	switch {
+	case hasPrefixWords(action, "ADD", "CONSTRAINT") && len(action) > 3:
+		name := unquoteIdentifier(action[2])
+		rest := strings.Join(action[3:], " ")
+		parsePostgresTableConstraint(table, name, rest)
+	case hasPrefixWords(action, "ALTER", "COLUMN") && len(action) > 3:
+		column, exists := table.Columns[unquoteIdentifier(action[2])]
+		if !exists {
+			return
+		}
+
+		setsSequence := hasPrefixWords(action[3:], "SET", "DEFAULT") && len(action) > 5 &&
+			strings.HasPrefix(strings.ToLower(action[5]), "nextval(")
+		addsIdentity := hasPrefixWords(action[3:], "ADD", "GENERATED")
+		if setsSequence || addsIdentity {
+			table.Columns[column.Name] = newColumn(column.Name, column.Type, autoIncrementOther(column.Other), column.Ordinal)
+		}
+	}
+}
+
+func parsePostgresCreateIndex(words []string, statement string, tables map[string]Table) {
+
+	unique := strings.ToUpper(words[1]) == "UNIQUE"
+
+	on := -1
+	for i, word := range words {
+		if strings.ToUpper(word) == "ON" {
+			on = i
+			break
+		}
+	}
+	if on < 0 || on+1 >= len(words) {
+		return
+	}
+
+	tableIndex := on + 1
+	if strings.ToUpper(words[tableIndex]) == "ONLY" {
+		tableIndex++
+	}
+	if tableIndex >= len(words) {
+		return
+	}
+
+	table, exists := tables[unquoteIdentifier(strings.SplitN(words[tableIndex], "(", 2)[0])]
+	if !exists {
+		return
+	}
+
+	name := unquoteIdentifier(words[on-1])
+	columns, _ := parenList(statement[strings.Index(statement, words[tableIndex]):])
+	columnName := joinColumnNames(columns)
+
+	if unique {
+		addConstraint(table, Constraint{
+			Name:       name,
+			ColumnName: columnName,
+			Type:       "UNIQUE",
+		})
+		return
+	}
+
+	table.Indexes[columnName] = Index{
+		Name:       name,
+		ColumnName: columnName,
+	}
+}
+
+// autoIncrementOther normalises a column attached to a sequence to the same
+// attributes a MySQL AUTO_INCREMENT column has.
+func autoIncrementOther(other string) string {
+	other = strings.TrimSpace(strings.Replace(other, "NOT NULL", "", 1))
+	other = strings.Join(strings.Fields(other), " ")
+
+	res := "NOT NULL"
+	if other != "" {
+		res += " " + other
+	}
+	return res + " AUTO_INCREMENT"
+}
+
+func stripTypeLength(word string) string {
+	if i := strings.IndexByte(word, '('); i >= 0 {
+		return word[:i]
+	}
+	return word
+}
+
+func nameOr(name string, fallback string) string {
+	if name != "" {
+		return name
+	}
+	return fallback
+}
+
+func firstLine(s string) string {
+	return strings.SplitN(s, "\n", 2)[0]
+}
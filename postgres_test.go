@@ -0,0 +1,76 @@
+package main
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestForeignKeyWithoutReferences(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		parse func(string) (map[string]Table, error)
+		dump  string
+	}{
+		{"postgres", ParsePostgresDump, "CREATE TABLE t (a int, FOREIGN KEY (a));"},
+		{"postgres alter table", ParsePostgresDump, "CREATE TABLE t (a int);\nALTER TABLE t ADD CONSTRAINT fk_a FOREIGN KEY (a);"},
+		{"sqlserver", ParseSQLServerDump, "CREATE TABLE t (a int, CONSTRAINT fk_a FOREIGN KEY (a));"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := tt.parse(tt.dump)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			table, exists := tables["t"]
+			if !exists {
+				t.Fatalf("table t not parsed")
+			}
+			if _, exists := table.Columns["a"]; !exists {
+				t.Errorf("column a not parsed")
+			}
+			if _, exists := table.Constraints["a"]["FOREIGN"]; exists {
+				t.Errorf("foreign key without REFERENCES parsed as %+v", table.Constraints["a"]["FOREIGN"])
+			}
+		})
+	}
+}
+
+func TestTablesWithoutColumnList(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		parse func(string) (map[string]Table, error)
+		dump  string
+	}{
+		{"postgres", ParsePostgresDump, "CREATE TABLE public.events (\n" +
+			"    id integer NOT NULL,\n" +
+			"    created_at timestamp without time zone NOT NULL\n" +
+			") PARTITION BY RANGE (created_at);\n" +
+			"CREATE TABLE public.events_2020 PARTITION OF public.events FOR VALUES FROM ('2020-01-01 00:00:00') TO ('2021-01-01 00:00:00');\n" +
+			"CREATE TABLE public.events_default PARTITION OF public.events DEFAULT;\n" +
+			"CREATE TABLE public.stats AS SELECT count(*) AS n FROM public.events;\n" +
+			"CREATE TABLE public.recent AS\n SELECT id FROM public.events WHERE (created_at > now());\n"},
+		{"sqlite", ParseSQLiteDump, "CREATE TABLE events (id INTEGER PRIMARY KEY, created_at TEXT NOT NULL);\n" +
+			"CREATE TABLE stats AS SELECT count(*) AS n FROM events;\n" +
+			"CREATE TABLE ids AS SELECT id FROM events;\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := tt.parse(tt.dump)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var names []string
+			for name := range tables {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if len(names) != 1 || names[0] != "events" {
+				t.Errorf("got tables %v, want [events]", names)
+			}
+		})
+	}
+}
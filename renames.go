@@ -0,0 +1,134 @@
+package main
+
+import (
+	"sort"
+)
+
+// detectTableRenames replaces the MISSING_TABLE diffs of tables whose columns
+// match those of a table only present in B by RENAMED_TABLE diffs. Tables are
+// matched by the Jaccard similarity of their column names, the most similar
+// pairs first, and only when it reaches threshold.
+func detectTableRenames(diffs []Diff, tablesA map[string]Table, tablesB map[string]Table, threshold float64) []Diff {
+
+	var removed, added []string
+	for _, d := range diffs {
+		if d.Type == MissingTable {
+			removed = append(removed, d.A)
+		}
+	}
+	for name := range tablesB {
+		if _, exists := tablesA[name]; !exists {
+			added = append(added, name)
+		}
+	}
+
+	type candidate struct {
+		from, to   string
+		similarity float64
+	}
+	var candidates []candidate
+	for _, from := range removed {
+		for _, to := range added {
+			similarity := jaccard(columnNames(tablesA[from]), columnNames(tablesB[to]))
+			if similarity >= threshold {
+				candidates = append(candidates, candidate{from, to, similarity})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].similarity != candidates[j].similarity {
+			return candidates[i].similarity > candidates[j].similarity
+		}
+		if candidates[i].from != candidates[j].from {
+			return candidates[i].from < candidates[j].from
+		}
+		return candidates[i].to < candidates[j].to
+	})
+
+	renamed := make(map[string]string)
+	taken := make(map[string]bool)
+	for _, c := range candidates {
+		if _, done := renamed[c.from]; done || taken[c.to] {
+			continue
+		}
+		renamed[c.from] = c.to
+		taken[c.to] = true
+	}
+
+	res := make([]Diff, 0, len(diffs))
+	for _, d := range diffs {
+		if to, ok := renamed[d.A]; ok && d.Type == MissingTable {
+			d = Diff{Type: RenamedTable, Severity: SeverityBreaking, Target: d.Target, A: d.A, B: to}
+		}
+		res = append(res, d)
+	}
+	return res
+}
+
+// detectColumnRenames replaces the MISSING_COLUMN diffs of columns with the
+// same type and attributes as a column only present in the same table of B
+// by RENAMED_COLUMN diffs. When several columns qualify, the one closest to
+// the original position wins.
+func detectColumnRenames(diffs []Diff, tablesA map[string]Table, tablesB map[string]Table) []Diff {
+
+	taken := make(map[string]bool)
+	res := make([]Diff, 0, len(diffs))
+	for _, d := range diffs {
+		if d.Type != MissingColumn {
+			res = append(res, d)
+			continue
+		}
+
+		columnA := tablesA[d.Target].Columns[d.A]
+		var match *Column
+		for _, columnB := range tablesB[d.Target].SortedColumns() {
+			if _, exists := tablesA[d.Target].Columns[columnB.Name]; exists || taken[d.Target+"."+columnB.Name] {
+				continue
+			}
+			if columnB.Type != columnA.Type || columnB.Other != columnA.Other {
+				continue
+			}
+			if match == nil || distance(columnB.Ordinal, columnA.Ordinal) < distance(match.Ordinal, columnA.Ordinal) {
+				columnB := columnB
+				match = &columnB
+			}
+		}
+
+		if match == nil {
+			res = append(res, d)
+			continue
+		}
+		taken[d.Target+"."+match.Name] = true
+		res = append(res, Diff{Type: RenamedColumn, Severity: SeverityBreaking, Target: d.Target, A: d.A, B: match.Name})
+	}
+	return res
+}
+
+func distance(a int, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func columnNames(table Table) map[string]bool {
+	names := make(map[string]bool, len(table.Columns))
+	for name := range table.Columns {
+		names[name] = true
+	}
+	return names
+}
+
+func jaccard(a map[string]bool, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for name := range a {
+		if b[name] {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	createRoutine = regexp.MustCompile(`(?is)^CREATE\s+(?:DEFINER\s*=\s*\S+\s+)?(PROCEDURE|FUNCTION)\s+(?:IF\s+NOT\s+EXISTS\s+)?([^\s(]+)\s*\(`)
+	// the character set and collation of a function's string return type
+	returnTypeAttribute = regexp.MustCompile(`(?i)^(?:CHARSET|CHARACTER SET|COLLATE) \S+ ?`)
+)
+
+type Routine struct {
+	Name string
+	// PROCEDURE or FUNCTION
+	Kind string
+	// the parameter list without its parentheses, whitespace-normalized
+	Parameters string
+	// the RETURNS type of a function, empty for a procedure
+	ReturnType string
+	// the characteristics, like DETERMINISTIC, and the statement run,
+	// whitespace-normalized
+	Body string
+}
+
+// parseRoutine parses a CREATE PROCEDURE or CREATE FUNCTION statement of a
+// MySQL schema. Routine bodies span several statements, so they are read
+// between the DELIMITER $$ or ;; lines that mysqldump and migration scripts
+// put around them. It returns false for other statements.
+func parseRoutine(statement string, schemaPrefix string) (Routine, bool) {
+
+	match := createRoutine.FindStringSubmatchIndex(statement)
+	if match == nil {
+		return Routine{}, false
+	}
+	open := match[1] - 1
+	end := matchingParen(statement, open)
+	if end < 0 {
+		return Routine{}, false
+	}
+
+	routine := Routine{
+		Name:       mysqlTableName(statement[match[4]:match[5]], schemaPrefix),
+		Kind:       strings.ToUpper(statement[match[2]:match[3]]),
+		Parameters: normalizeExpression(statement[open+1 : end]),
+	}
+	rest := normalizeExpression(statement[end+1:])
+	if routine.Kind == "FUNCTION" && hasPrefixWords(strings.Fields(rest), "RETURNS") {
+		rest = strings.TrimSpace(rest[len("RETURNS"):])
+		routine.ReturnType = leadingValue(rest)
+		rest = strings.TrimSpace(rest[len(routine.ReturnType):])
+		for {
+			attribute := returnTypeAttribute.FindString(rest)
+			if attribute == "" {
+				break
+			}
+			routine.ReturnType += " " + strings.TrimSpace(attribute)
+			rest = rest[len(attribute):]
+		}
+	}
+	routine.Body = rest
+	return routine, true
+}
+
+// routineKey keeps procedures and functions apart, since MySQL allows a
+// procedure and a function with the same name.
+func routineKey(r Routine) string {
+	return r.Kind + " " + r.Name
+}
+
+// compareRoutines reports the routines of routineMapA missing from
+// routineMapB and those whose parameters, return type or body differ.
+func compareRoutines(routineMapA map[string]Routine, routineMapB map[string]Routine) []Diff {
+
+	keys := make([]string, 0, len(routineMapA))
+	for key := range routineMapA {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	diffs := make([]Diff, 0)
+	for _, key := range keys {
+		routineA := routineMapA[key]
+		routineB, exists := routineMapB[key]
+
+		var d Diff
+		switch {
+		case !exists:
+			d = Diff{Type: MissingRoutine, Target: routineA.Name, A: key, B: ""}
+		case routineA.Parameters != routineB.Parameters || routineA.ReturnType != routineB.ReturnType:
+			d = Diff{Type: WrongRoutineBody, Target: routineA.Name, A: routineDefinition(routineA), B: routineDefinition(routineB)}
+		case routineA.Body != routineB.Body:
+			d = Diff{Type: WrongRoutineBody, Target: routineA.Name, A: routineA.Body, B: routineB.Body}
+		default:
+			continue
+		}
+		d.Severity = classifyDiff(d)
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// routineDefinition renders what follows the routine name in its CREATE
+// statement, for diffs where more than the body changed.
+func routineDefinition(r Routine) string {
+	def := "(" + r.Parameters + ")"
+	if r.ReturnType != "" {
+		def += " RETURNS " + r.ReturnType
+	}
+	return def + " " + r.Body
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Weights of the parts of a schema in ScoreSchemas. A missing table costs
+// its own weight plus that of everything in it.
+const (
+	tableScoreWeight      = 10
+	columnTypeScoreWeight = 3
+	attributeScoreWeight  = 1
+)
+
+// ScoreSchemas returns how similar two schemas are, from 1.0 when they are
+// identical down to 0.0 when they have nothing in common. Missing tables
+// weigh the most, then columns whose type changed, then the other column
+// attributes, indexes and constraints. The score is symmetric.
+func ScoreSchemas(a, b map[string]Table) float64 {
+
+	var total, penalty float64
+	for _, name := range unionKeys(a, b) {
+		tableA, inA := a[name]
+		tableB, inB := b[name]
+		if !inA || !inB {
+			weight := tableScore(tableA)
+			if !inA {
+				weight = tableScore(tableB)
+			}
+			total += weight
+			penalty += weight
+			continue
+		}
+
+		total += tableScoreWeight
+		for _, columnName := range unionKeys(tableA.Columns, tableB.Columns) {
+			columnA, inA := tableA.Columns[columnName]
+			columnB, inB := tableB.Columns[columnName]
+			total += columnTypeScoreWeight + attributeScoreWeight
+			switch {
+			case !inA || !inB:
+				penalty += columnTypeScoreWeight + attributeScoreWeight
+			case !(CompareOptions{}).sameType(columnA.Type, columnB.Type):
+				penalty += columnTypeScoreWeight
+			case columnA.Nullable != columnB.Nullable || columnA.Default != columnB.Default || columnA.Other != columnB.Other:
+				penalty += attributeScoreWeight
+			}
+		}
+
+		for _, key := range unionKeys(tableA.Indexes, tableB.Indexes) {
+			indexA, inA := tableA.Indexes[key]
+			indexB, inB := tableB.Indexes[key]
+			total += attributeScoreWeight
+			if !inA || !inB || indexA.Name != indexB.Name || indexA.Kind != indexB.Kind || indexA.PrefixLength != indexB.PrefixLength {
+				penalty += attributeScoreWeight
+			}
+		}
+
+		constraintsA, constraintsB := constraintsByKey(tableA), constraintsByKey(tableB)
+		for _, key := range unionKeys(constraintsA, constraintsB) {
+			constraintA, inA := constraintsA[key]
+			constraintB, inB := constraintsB[key]
+			total += attributeScoreWeight
+			if !inA || !inB || constraintA.Other != constraintB.Other || constraintA.MatchType != constraintB.MatchType {
+				penalty += attributeScoreWeight
+			}
+		}
+	}
+
+	if total == 0 {
+		return 1
+	}
+	return 1 - penalty/total
+}
+
+// tableScore is the weight of a table and everything in it.
+func tableScore(t Table) float64 {
+	weight := float64(tableScoreWeight)
+	weight += float64(len(t.Columns)) * (columnTypeScoreWeight + attributeScoreWeight)
+	weight += float64(len(t.Indexes)+len(constraintsByKey(t))) * attributeScoreWeight
+	return weight
+}
+
+// constraintsByKey flattens the constraints of a table, keyed by their
+// columns and type.
+func constraintsByKey(t Table) map[string]Constraint {
+	constraints := make(map[string]Constraint)
+	for columnName, byType := range t.Constraints {
+		for constraintType, c := range byType {
+			constraints[columnName+" "+constraintType] = c
+		}
+	}
+	return constraints
+}
+
+// unionKeys returns the keys of two maps with string keys, sorted.
+func unionKeys(a, b interface{}) []string {
+	seen := make(map[string]bool)
+	for _, m := range []interface{}{a, b} {
+		switch m := m.(type) {
+		case map[string]Table:
+			for k := range m {
+				seen[k] = true
+			}
+		case map[string]Column:
+			for k := range m {
+				seen[k] = true
+			}
+		case map[string]Index:
+			for k := range m {
+				seen[k] = true
+			}
+		case map[string]Constraint:
+			for k := range m {
+				seen[k] = true
+			}
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// runScore prints the similarity score of the two schemas, after
+// -include-tables and -exclude-tables, and reports whether they differ.
+func runScore(opts runOptions, pathA string, pathB string) (bool, error) {
+
+	pipeline := opts.pipeline()
+
+	schemaA, _, err := parseSource(pathA, opts.DSNA, opts)
+	if err != nil {
+		return false, fmt.Errorf("error reading file 1: %s, %v", pathA, err)
+	}
+	schemaB, _, err := parseSource(pathB, opts.DSNB, opts)
+	if err != nil {
+		return false, fmt.Errorf("error reading file 2: %s, %v", pathB, err)
+	}
+
+	score := ScoreSchemas(pipeline.Filter.Filter(schemaA).Tables, pipeline.Filter.Filter(schemaB).Tables)
+	fmt.Printf("%.4f\n", score)
+	return score < 1, nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sequence is a PostgreSQL CREATE SEQUENCE. The options are kept as written,
+// empty when left out or set to NO MINVALUE or NO MAXVALUE.
+type Sequence struct {
+	Name        string
+	StartWith   string
+	IncrementBy string
+	MinValue    string
+	MaxValue    string
+	// CYCLE or NO CYCLE
+	CycleOption string
+}
+
+// parsePostgresSequences returns the CREATE SEQUENCE statements of a pg_dump
+// file, by name without the schema prefix like the tables.
+func parsePostgresSequences(data string) map[string]Sequence {
+
+	sequences := make(map[string]Sequence)
+	for _, statement := range splitStatements(data) {
+		words := strings.Fields(statement)
+		if len(words) > 1 && strings.ToUpper(words[0]) == "CREATE" {
+			// CREATE TEMPORARY SEQUENCE, CREATE UNLOGGED SEQUENCE
+			switch strings.ToUpper(words[1]) {
+			case "TEMP", "TEMPORARY", "UNLOGGED":
+				words = append(words[:1], words[2:]...)
+			}
+		}
+		if !hasPrefixWords(words, "CREATE", "SEQUENCE") || len(words) < 3 {
+			continue
+		}
+		words = words[2:]
+		if hasPrefixWords(words, "IF", "NOT", "EXISTS") && len(words) > 3 {
+			words = words[3:]
+		}
+
+		sequence := Sequence{Name: unquoteIdentifier(words[0]), CycleOption: "NO CYCLE"}
+		for i := 1; i < len(words); i++ {
+			// the value after the option keywords, if any
+			value := func(keywords int) string {
+				if i+keywords < len(words) {
+					i += keywords
+					return words[i]
+				}
+				return ""
+			}
+			switch rest := words[i:]; {
+			case hasPrefixWords(rest, "START", "WITH"):
+				sequence.StartWith = value(2)
+			case hasPrefixWords(rest, "START"):
+				sequence.StartWith = value(1)
+			case hasPrefixWords(rest, "INCREMENT", "BY"):
+				sequence.IncrementBy = value(2)
+			case hasPrefixWords(rest, "INCREMENT"):
+				sequence.IncrementBy = value(1)
+			case hasPrefixWords(rest, "MINVALUE"):
+				sequence.MinValue = value(1)
+			case hasPrefixWords(rest, "MAXVALUE"):
+				sequence.MaxValue = value(1)
+			case hasPrefixWords(rest, "NO", "CYCLE"):
+				sequence.CycleOption = "NO CYCLE"
+				i++
+			case hasPrefixWords(rest, "NO"):
+				// NO MINVALUE, NO MAXVALUE
+				i++
+			case hasPrefixWords(rest, "CYCLE"):
+				sequence.CycleOption = "CYCLE"
+			case hasPrefixWords(rest, "AS"), hasPrefixWords(rest, "CACHE"):
+				i++
+			case hasPrefixWords(rest, "OWNED", "BY"):
+				i += 2
+			}
+		}
+		sequences[sequence.Name] = sequence
+	}
+	return sequences
+}
+
+// addImplicitSequences adds the sequences SERIAL and identity columns own,
+// named <table>_<column>_seq, which pg_dump writes out and a hand-written
+// schema leaves implicit. They have the default options.
+func addImplicitSequences(sequences map[string]Sequence, tables map[string]Table) {
+	for _, table := range tables {
+		for _, column := range table.Columns {
+			name := table.Name + "_" + column.Name + "_seq"
+			if _, exists := sequences[name]; exists || findWord(column.Other, "AUTO_INCREMENT") < 0 {
+				continue
+			}
+			sequences[name] = Sequence{Name: name, StartWith: "1", IncrementBy: "1", CycleOption: "NO CYCLE"}
+		}
+	}
+}
+
+// compareSequences reports the sequences of sequenceMapA missing from
+// sequenceMapB and every option that differs, as its own diff.
+func compareSequences(sequenceMapA map[string]Sequence, sequenceMapB map[string]Sequence) []Diff {
+
+	names := make([]string, 0, len(sequenceMapA))
+	for name := range sequenceMapA {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	diffs := make([]Diff, 0)
+	emit := func(d Diff) {
+		d.Severity = classifyDiff(d)
+		diffs = append(diffs, d)
+	}
+	for _, name := range names {
+		sequenceA := sequenceMapA[name]
+		sequenceB, exists := sequenceMapB[name]
+		if !exists {
+			emit(Diff{Type: MissingSequence, Target: name, A: name, B: ""})
+			continue
+		}
+
+		options := []struct {
+			keyword string
+			a, b    string
+		}{
+			{"START WITH", sequenceA.StartWith, sequenceB.StartWith},
+			{"INCREMENT BY", sequenceA.IncrementBy, sequenceB.IncrementBy},
+			{"MINVALUE", sequenceA.MinValue, sequenceB.MinValue},
+			{"MAXVALUE", sequenceA.MaxValue, sequenceB.MaxValue},
+		}
+		for _, option := range options {
+			if option.a != option.b {
+				emit(Diff{Type: WrongSequenceOption, Target: name, A: sequenceOption(option.keyword, option.a), B: sequenceOption(option.keyword, option.b)})
+			}
+		}
+		if sequenceA.CycleOption != sequenceB.CycleOption {
+			emit(Diff{Type: WrongSequenceOption, Target: name, A: sequenceA.CycleOption, B: sequenceB.CycleOption})
+		}
+	}
+	return diffs
+}
+
+// sequenceOption renders an option as written in CREATE SEQUENCE, empty
+// when left out.
+func sequenceOption(keyword string, value string) string {
+	switch {
+	case value == "" && (keyword == "MINVALUE" || keyword == "MAXVALUE"):
+		return "NO " + keyword
+	case value == "":
+		return ""
+	}
+	return fmt.Sprintf("%s %s", keyword, value)
+}
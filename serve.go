@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// maxRequestSize bounds the body of a POST /compare, and the message of a
+// /compare/stream client, both schemas included.
+const maxRequestSize = 64 << 20
+
+type compareRequest struct {
+	SchemaA string `json:"schema_a"`
+	SchemaB string `json:"schema_b"`
+}
+
+// newServer returns the handler of sqlcompare serve: POST /compare compares
+// the schema_a and schema_b of its JSON body, with the flags of the command,
+// and returns the diffs as a JSON array, /compare/stream does the same over
+// a WebSocket, see streamDiffs, and GET /health returns 200.
+func newServer(opts runOptions) http.Handler {
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeJSONError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"status": "ok"}`)
+	})
+
+	mux.HandleFunc("/compare", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			writeJSONError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		var req compareRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req); err != nil {
+			writeJSONError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %v", err))
+			return
+		}
+
+		diffs, err := compareStrings(opts, req.SchemaA, req.SchemaB)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+		if diffs == nil {
+			diffs = make([]Diff, 0)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(diffs)
+	})
+
+	mux.HandleFunc("/compare/stream", func(w http.ResponseWriter, r *http.Request) {
+		if ws := upgradeWebSocket(w, r); ws != nil {
+			streamDiffs(ws, opts)
+		}
+	})
+	return mux
+}
+
+// streamDiffs reads the schema_a and schema_b of the first message of a
+// /compare/stream client and sends every diff of the compare stage as a
+// JSON message, the same diffs POST /compare returns, then closes the
+// connection. An invalid request gets an {"error": ...} message instead.
+func streamDiffs(ws *websocketConn, opts runOptions) {
+
+	message, err := ws.readMessage(maxRequestSize)
+	if err != nil {
+		if wsErr, ok := err.(*websocketError); ok {
+			ws.close(wsErr.code, wsErr.reason)
+		} else {
+			ws.conn.Close()
+		}
+		return
+	}
+
+	var req compareRequest
+	if err := json.Unmarshal(message, &req); err != nil {
+		ws.writeJSON(map[string]string{"error": fmt.Sprintf("invalid request: %v", err)})
+		ws.close(closeInvalidData, "invalid request")
+		return
+	}
+	diffs, err := compareStrings(opts, req.SchemaA, req.SchemaB)
+	if err != nil {
+		ws.writeJSON(map[string]string{"error": err.Error()})
+		ws.close(closeInvalidData, "invalid schema")
+		return
+	}
+
+	// a client gone away fails the write, and the diffs left are skipped
+	for _, d := range diffs {
+		if err := ws.writeJSON(d); err != nil {
+			ws.conn.Close()
+			return
+		}
+	}
+	ws.close(closeNormal, "")
+}
+
+// compareStrings runs the parse, normalize, filter and compare stages on two
+// schemas given as strings.
+func compareStrings(opts runOptions, dataA string, dataB string) ([]Diff, error) {
+
+	schemas, err := parseStrings(opts, dataA, dataB)
+	if err != nil {
+		return nil, err
+	}
+	diffs := opts.pipeline().Compare.Compare(schemas[0], schemas[1])
+	return filterDiffTypes(diffs, opts.DiffTypes), nil
+}
+
+// parseStrings runs the parse, normalize and filter stages on two schemas
+// given as strings.
+func parseStrings(opts runOptions, dataA string, dataB string) ([2]Schema, error) {
+
+	pipeline := opts.pipeline()
+
+	var schemas [2]Schema
+	for i, data := range []string{dataA, dataB} {
+		schema, _, err := pipeline.Parse.Parse(strings.NewReader(data))
+		if err != nil {
+			return schemas, fmt.Errorf("error parsing schema_%s: %v", []string{"a", "b"}[i], err)
+		}
+		schemas[i] = pipeline.Filter.Filter(pipeline.Normalize.Normalize(schema))
+	}
+	return schemas, nil
+}
+
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// runServer serves the HTTP API on port until it fails.
+func runServer(opts runOptions, port int) error {
+	addr := fmt.Sprintf(":%d", port)
+	log.Printf("listening on %s", addr)
+	return http.ListenAndServe(addr, newServer(opts))
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	serveSchemaA = "CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `email` varchar(255) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE TABLE `orders` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+	serveSchemaB = "CREATE TABLE `users` (\n" +
+		"  `id` bigint NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+)
+
+// dialWebSocket opens a WebSocket to path on server.
+func dialWebSocket(t *testing.T, server *httptest.Server, path string) (net.Conn, *bufio.Reader) {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	fmt.Fprintf(conn, "GET %s HTTP/1.1\r\nHost: localhost\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n"+
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n\r\n", path)
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("handshake: got status %d", resp.StatusCode)
+	}
+	// the example of RFC 6455 section 1.3
+	if got := resp.Header.Get("Sec-WebSocket-Accept"); got != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("handshake: got Sec-WebSocket-Accept %q", got)
+	}
+	return conn, r
+}
+
+// writeClientFrame writes a masked frame, as a client must.
+func writeClientFrame(t *testing.T, w io.Writer, fin bool, opcode byte, payload []byte) {
+	t.Helper()
+
+	first := opcode
+	if fin {
+		first |= 0x80
+	}
+	header := []byte{first}
+	switch size := len(payload); {
+	case size < 126:
+		header = append(header, 0x80|byte(size))
+	case size <= 0xffff:
+		header = append(header, 0x80|126, byte(size>>8), byte(size))
+	default:
+		header = append(header, 0x80|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(size))
+	}
+	mask := []byte{0x12, 0x34, 0x56, 0x78}
+	masked := make([]byte, len(payload))
+	for i := range payload {
+		masked[i] = payload[i] ^ mask[i%4]
+	}
+
+	if _, err := w.Write(append(append(header, mask...), masked...)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readServerFrame reads an unmasked frame of the server.
+func readServerFrame(t *testing.T, r *bufio.Reader) (byte, []byte) {
+	t.Helper()
+
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		t.Fatal(err)
+	}
+	if header[1]&0x80 != 0 {
+		t.Fatal("masked server frame")
+	}
+	size := uint64(header[1])
+	switch size {
+	case 126:
+		var extended [2]byte
+		io.ReadFull(r, extended[:])
+		size = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		io.ReadFull(r, extended[:])
+		size = binary.BigEndian.Uint64(extended[:])
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatal(err)
+	}
+	return header[0] & 0x0f, payload
+}
+
+// readUntilClose returns the text messages of the server and the status
+// code of its close frame.
+func readUntilClose(t *testing.T, r *bufio.Reader) ([]string, uint16) {
+	t.Helper()
+
+	var messages []string
+	for {
+		opcode, payload := readServerFrame(t, r)
+		switch opcode {
+		case opText:
+			messages = append(messages, string(payload))
+		case opClose:
+			if len(payload) < 2 {
+				t.Fatal("close frame without a status code")
+			}
+			return messages, binary.BigEndian.Uint16(payload)
+		default:
+			t.Fatalf("unexpected opcode %d", opcode)
+		}
+	}
+}
+
+func compareRequestJSON(t *testing.T, schemaA string, schemaB string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(compareRequest{SchemaA: schemaA, SchemaB: schemaB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestCompareStream(t *testing.T) {
+
+	opts := runOptions{Dialect: "mysql", Format: "json"}
+	server := httptest.NewServer(newServer(opts))
+	defer server.Close()
+
+	want, err := compareStrings(opts, serveSchemaA, serveSchemaB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, r := dialWebSocket(t, server, "/compare/stream")
+	request := compareRequestJSON(t, serveSchemaA, serveSchemaB)
+	// sent in two fragments with a ping in between
+	writeClientFrame(t, conn, false, opText, request[:10])
+	writeClientFrame(t, conn, true, opPing, []byte("ping"))
+	writeClientFrame(t, conn, true, opContinuation, request[10:])
+
+	if opcode, payload := readServerFrame(t, r); opcode != opPong || string(payload) != "ping" {
+		t.Fatalf("got opcode %d %q, want a pong", opcode, payload)
+	}
+
+	messages, code := readUntilClose(t, r)
+	if code != closeNormal {
+		t.Errorf("got close code %d, want %d", code, closeNormal)
+	}
+	got := make(map[Diff]bool)
+	for _, message := range messages {
+		var d Diff
+		if err := json.Unmarshal([]byte(message), &d); err != nil {
+			t.Fatalf("message %q: %v", message, err)
+		}
+		got[d] = true
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d diffs %v, want %v", len(got), messages, want)
+	}
+	for _, d := range want {
+		if !got[d] {
+			t.Errorf("diff %+v not streamed", d)
+		}
+	}
+}
+
+func TestCompareStreamInvalidRequest(t *testing.T) {
+
+	server := httptest.NewServer(newServer(runOptions{Dialect: "mysql"}))
+	defer server.Close()
+
+	conn, r := dialWebSocket(t, server, "/compare/stream")
+	writeClientFrame(t, conn, true, opText, []byte("{not json"))
+
+	messages, code := readUntilClose(t, r)
+	if code != closeInvalidData {
+		t.Errorf("got close code %d, want %d", code, closeInvalidData)
+	}
+	if len(messages) != 1 || !strings.Contains(messages[0], `"error"`) {
+		t.Errorf("got messages %q, want one error", messages)
+	}
+}
+
+func TestCompareStreamRequiresHandshake(t *testing.T) {
+
+	server := httptest.NewServer(newServer(runOptions{Dialect: "mysql"}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/compare/stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestCompareStreamMatchesCompare(t *testing.T) {
+
+	tests := []struct {
+		name string
+		opts runOptions
+	}{
+		{"additive only", runOptions{Dialect: "mysql", AdditiveOnly: true}},
+		{"detect renames", runOptions{Dialect: "mysql", DetectRenames: true, RenameThreshold: 0.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(newServer(tt.opts))
+			defer server.Close()
+
+			want, err := compareStrings(tt.opts, serveSchemaB, serveSchemaA)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(want) == 0 {
+				t.Fatal("no diffs to stream")
+			}
+
+			conn, r := dialWebSocket(t, server, "/compare/stream")
+			writeClientFrame(t, conn, true, opText, compareRequestJSON(t, serveSchemaB, serveSchemaA))
+
+			messages, code := readUntilClose(t, r)
+			if code != closeNormal {
+				t.Errorf("got close code %d, want %d", code, closeNormal)
+			}
+			if len(messages) != len(want) {
+				t.Fatalf("got %d diffs %v, want %+v", len(messages), messages, want)
+			}
+			for i, message := range messages {
+				var d Diff
+				if err := json.Unmarshal([]byte(message), &d); err != nil {
+					t.Fatalf("message %q: %v", message, err)
+				}
+				if d != want[i] {
+					t.Errorf("diff %d: got %+v, want %+v", i, d, want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+const (
+	SeverityBreaking = "BREAKING"
+	SeverityWarning  = "WARNING"
+	SeverityInfo     = "INFO"
+)
+
+// classifyDiff returns how dangerous applying the diff is: BREAKING when it
+// drops data or breaks queries and writes that used to work, WARNING when it
+// changes behaviour or performance, and INFO when it is cosmetic.
+func classifyDiff(d Diff) string {
+	switch d.Type {
+	case MissingTable, RenamedTable, MissingView, MissingRoutine, MissingSequence, MissingColumn, RenamedColumn, WrongColumnType, NullableToNotNullWithoutDefault, SensitiveColumnChanged:
+		return SeverityBreaking
+	case WrongSetValues:
+		// rows holding a removed value no longer fit, added ones are harmless
+		if d.A != "" {
+			return SeverityBreaking
+		}
+		return SeverityInfo
+	case WrongIndexName, WrongFKMatchType, WrongColumnFamily, ExtraTable, ExtraColumn, ExtraIndex:
+		return SeverityInfo
+	}
+	return SeverityWarning
+}
+
+// diffSeverity returns the severity set on the diff by the comparison,
+// which knows more than its type, falling back to classifyDiff.
+func diffSeverity(d Diff) string {
+	if d.Severity != "" {
+		return d.Severity
+	}
+	return classifyDiff(d)
+}
+
+func isBreaking(d Diff) bool {
+	return diffSeverity(d) == SeverityBreaking
+}
+
+func hasBreaking(diffs []Diff) bool {
+	for _, d := range diffs {
+		if isBreaking(d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// printSideBySide prints the diffs grouped by the object they are about, one
+// row per diff with the definition in A and the definition in B next to each
+// other, e.g. INT | BIGINT for a changed column type. The object is only
+// named on its first row.
+func printSideBySide(out io.Writer, diffs []Diff, aFileName string, bFileName string, color bool) {
+
+	byTarget := make(map[string][]Diff)
+	var targets []string
+	for _, d := range diffs {
+		if _, seen := byTarget[d.Target]; !seen {
+			targets = append(targets, d.Target)
+		}
+		byTarget[d.Target] = append(byTarget[d.Target], d)
+	}
+	sort.Strings(targets)
+
+	// every cell of a column is coloured, so tabwriter keeps them aligned
+	paint := func(code string, s string) string {
+		if color {
+			return sgr(code, s)
+		}
+		return s
+	}
+
+	w := tabwriter.NewWriter(out, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(out, "\n\nDiffs\n\n")
+	fmt.Fprintf(w, "%s\t%s\t%s\t| %s\n", paint(styleNormal, "Target"), paint(colorDefault, "Type"), paint(colorDefault, aFileName), paint(colorDefault, bFileName))
+	for _, target := range targets {
+		for i, d := range byTarget[target] {
+			name := target
+			if i > 0 {
+				name = ""
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t| %s\n", paint(styleNormal, name), paint(diffTypeColor(d.Type), d.Type), paint(colorRed, d.A), paint(colorGreen, d.B))
+		}
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "\n%s\nSummary: %s\n", strings.Repeat("-", 40), summaryLine(summarise(diffs)))
+	fmt.Fprintln(out)
+}
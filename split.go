@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// writeSplitOutput writes the diffs of every table to its own file in dir,
+// <table>.diff.txt in the text format or <table>.diff.json in the json one.
+// Tables without diffs get no file.
+func writeSplitOutput(diffs []Diff, dir string, format string) error {
+
+	extension := ""
+	switch format {
+	case "text":
+		extension = ".diff.txt"
+	case "json":
+		extension = ".diff.json"
+	default:
+		return fmt.Errorf("-split-output only supports the text and json formats")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for tableName, tableDiffs := range groupByTable(diffs) {
+		fileName := strings.ReplaceAll(tableName, string(os.PathSeparator), "_") + extension
+		f, err := os.Create(filepath.Join(dir, fileName))
+		if err != nil {
+			return err
+		}
+
+		if format == "json" {
+			enc := json.NewEncoder(f)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(tableDiffs)
+		} else {
+			printDiffs(f, tableDiffs, "A", "B", textOptions{GroupBy: "type"})
+		}
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", fileName, err)
+		}
+	}
+	return nil
+}
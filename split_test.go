@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const (
+	splitSchemaA = "CREATE TABLE `orders` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `total` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `email` varchar(255) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE TABLE `tags` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+	splitSchemaB = "CREATE TABLE `orders` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `total` bigint NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE TABLE `tags` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+)
+
+// runSplitOutput compares the split schemas with -split-output and returns
+// the directory the files are written to.
+func runSplitOutput(t *testing.T, format string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	pathA := filepath.Join(dir, "a.sql")
+	pathB := filepath.Join(dir, "b.sql")
+	for path, schema := range map[string]string{pathA: splitSchemaA, pathB: splitSchemaB} {
+		if err := ioutil.WriteFile(path, []byte(schema), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := filepath.Join(dir, "out")
+	differ, err := run(runOptions{Dialect: "mysql", Format: format, SplitOutput: out}, pathA, pathB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !differ {
+		t.Error("run reported no differences")
+	}
+	return out
+}
+
+func splitFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestSplitOutputText(t *testing.T) {
+
+	dir := runSplitOutput(t, "text")
+
+	files := splitFiles(t, dir)
+	if want := []string{"orders.diff.txt", "users.diff.txt"}; strings.Join(files, " ") != strings.Join(want, " ") {
+		t.Fatalf("got files %q, want %q", files, want)
+	}
+
+	orders, err := ioutil.ReadFile(filepath.Join(dir, "orders.diff.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(orders), WrongColumnType) || strings.Contains(string(orders), "email") {
+		t.Errorf("orders.diff.txt does not hold only the orders diffs:\n%s", orders)
+	}
+	users, err := ioutil.ReadFile(filepath.Join(dir, "users.diff.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(users), MissingColumn) || strings.Contains(string(users), "total") {
+		t.Errorf("users.diff.txt does not hold only the users diffs:\n%s", users)
+	}
+}
+
+func TestSplitOutputJSON(t *testing.T) {
+
+	dir := runSplitOutput(t, "json")
+
+	files := splitFiles(t, dir)
+	if want := []string{"orders.diff.json", "users.diff.json"}; strings.Join(files, " ") != strings.Join(want, " ") {
+		t.Fatalf("got files %q, want %q", files, want)
+	}
+
+	tests := []struct {
+		file     string
+		diffType string
+		target   string
+	}{
+		{"orders.diff.json", WrongColumnType, "orders.total"},
+		{"users.diff.json", MissingColumn, "users"},
+	}
+	for _, tt := range tests {
+		data, err := ioutil.ReadFile(filepath.Join(dir, tt.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var diffs []Diff
+		if err := json.Unmarshal(data, &diffs); err != nil {
+			t.Fatalf("%s: %v", tt.file, err)
+		}
+		if len(diffs) != 1 || diffs[0].Type != tt.diffType || diffs[0].Target != tt.target {
+			t.Errorf("%s: got %+v, want one %s diff on %s", tt.file, diffs, tt.diffType, tt.target)
+		}
+	}
+}
+
+func TestSplitOutputUnsupportedFormat(t *testing.T) {
+
+	dir := filepath.Join(t.TempDir(), "out")
+	if err := writeSplitOutput([]Diff{{Type: MissingTable, Target: "users"}}, dir, "html"); err == nil {
+		t.Fatal("expected an error for the html format")
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("output directory created for an unsupported format")
+	}
+}
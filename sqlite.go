@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	sqliteAutoincrement   = regexp.MustCompile(`(?i)\s+AUTOINCREMENT\b`)
+	sqlitePrimaryKeyOrder = regexp.MustCompile(`(?i)(PRIMARY\s+KEY)\s+(ASC|DESC)\b`)
+)
+
+// words that may follow the name of a column declared without a type
+var sqliteColumnConstraints = []string{"CONSTRAINT", "PRIMARY", "NOT", "NULL", "UNIQUE", "CHECK", "DEFAULT", "COLLATE", "REFERENCES", "GENERATED", "AS"}
+
+// ParseSQLiteDump parses the output of sqlite3 .schema and .dump. Tables go
+// through the PostgreSQL parser, which handles the same quoting and
+// constraint syntax, with SQLite's own rules on top: columns may have no
+// type and an INTEGER PRIMARY KEY column is stored as AUTO_INCREMENT, since
+// it is an alias of the rowid. SQLite's internal sqlite_* tables are skipped.
+func ParseSQLiteDump(data string) (map[string]Table, error) {
+
+	tables := make(map[string]Table)
+
+	for _, statement := range splitStatements(data) {
+		words := strings.Fields(statement)
+
+		switch {
+		case hasPrefixWords(words, "CREATE", "TABLE"),
+			hasPrefixWords(words, "CREATE", "TEMP", "TABLE"),
+			hasPrefixWords(words, "CREATE", "TEMPORARY", "TABLE"):
+			table, err := parseSQLiteCreateTable(statement)
+			if err != nil {
+				return nil, err
+			}
+			if table.Name != "" && !strings.HasPrefix(table.Name, "sqlite_") {
+				tables[table.Name] = table
+			}
+		case hasPrefixWords(words, "CREATE", "INDEX"),
+			hasPrefixWords(words, "CREATE", "UNIQUE", "INDEX"):
+			parsePostgresCreateIndex(words, statement, tables)
+		}
+	}
+
+	return tables, nil
+}
+
+func parseSQLiteCreateTable(statement string) (Table, error) {
+
+	open := strings.IndexByte(statement, '(')
+	if open < 0 || hasNoColumnList(statement[:open]) {
+		// CREATE TABLE ... AS SELECT
+		return Table{}, nil
+	}
+	end := matchingParen(statement, open)
+	if end < 0 {
+		return Table{}, fmt.Errorf("unbalanced parentheses in: %s", firstLine(statement))
+	}
+
+	// columns without a type get a placeholder one for the PostgreSQL
+	// parser, removed afterwards
+	var items []string
+	untyped := make(map[string]bool)
+	for _, item := range splitTopLevel(statement[open+1:end], ',') {
+		item = sqliteAutoincrement.ReplaceAllString(item, "")
+		item = sqlitePrimaryKeyOrder.ReplaceAllString(item, "$1")
+		words := strings.Fields(item)
+		if len(words) == 0 {
+			continue
+		}
+
+		isConstraint := hasPrefixWords(words, "CONSTRAINT") || hasPrefixWords(words, "PRIMARY", "KEY") ||
+			hasPrefixWords(words, "UNIQUE") || hasPrefixWords(words, "FOREIGN", "KEY") || hasPrefixWords(words, "CHECK")
+		if !isConstraint && (len(words) == 1 || isSQLiteColumnConstraint(words[1])) {
+			untyped[unquoteIdentifier(words[0])] = true
+			item = words[0] + " ANY " + strings.Join(words[1:], " ")
+		}
+		items = append(items, item)
+	}
+
+	table, err := parsePostgresCreateTable(statement[:open+1] + strings.Join(items, ",\n") + ")")
+	if err != nil || table.Name == "" {
+		return table, err
+	}
+
+	for name := range untyped {
+		column := table.Columns[name]
+		table.Columns[name] = newColumn(column.Name, "", column.Other, column.Ordinal)
+	}
+
+	for _, constraint := range table.SortedConstraints() {
+		column, exists := table.Columns[constraint.ColumnName]
+		if constraint.Type == "PRIMARY" && exists && strings.ToUpper(column.Type) == "INTEGER" {
+			table.Columns[column.Name] = newColumn(column.Name, column.Type, autoIncrementOther(column.Other), column.Ordinal)
+		}
+	}
+
+	return table, nil
+}
+
+func isSQLiteColumnConstraint(word string) bool {
+	for _, constraint := range sqliteColumnConstraints {
+		if strings.ToUpper(word) == constraint {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// T-SQL types and the MySQL types they are compared as
+var sqlServerTypeAliases = map[string]string{
+	"nvarchar":         "varchar",
+	"nchar":            "char",
+	"ntext":            "text",
+	"nvarchar(max)":    "longtext",
+	"varchar(max)":     "longtext",
+	"varbinary(max)":   "longblob",
+	"image":            "longblob",
+	"datetime2":        "datetime",
+	"datetime2(7)":     "datetime",
+	"smalldatetime":    "datetime",
+	"bit":              "tinyint(1)",
+	"uniqueidentifier": "char(36)",
+	"money":            "decimal(19,4)",
+	"smallmoney":       "decimal(10,4)",
+	"real":             "float",
+}
+
+var goSeparator = regexp.MustCompile(`(?mi)^[ \t]*GO[ \t]*\r?$`)
+
+// SSMS writes FOREIGN KEY([UserId]) without a space
+var keyParen = regexp.MustCompile(`(?i)\bKEY\(`)
+
+var identityClause = regexp.MustCompile(`(?i)\bIDENTITY(\s*\(\s*\d+\s*,\s*\d+\s*\))?`)
+
+// ParseSQLServerDump parses the scripts SQL Server Management Studio
+// generates. Batches are separated by GO lines, identifiers are quoted with
+// brackets, IDENTITY columns are stored as AUTO_INCREMENT and types are
+// normalised through sqlServerTypeAliases.
+func ParseSQLServerDump(data string) (map[string]Table, error) {
+
+	tables := make(map[string]Table)
+	data = goSeparator.ReplaceAllString(data, ";")
+	data = keyParen.ReplaceAllString(data, "KEY (")
+
+	for _, statement := range splitStatements(data) {
+		words := strings.Fields(statement)
+
+		switch {
+		case hasPrefixWords(words, "CREATE", "TABLE"):
+			table, err := parseSQLServerCreateTable(statement)
+			if err != nil {
+				return nil, err
+			}
+			if table.Name != "" {
+				tables[table.Name] = table
+			}
+		case hasPrefixWords(words, "ALTER", "TABLE") && len(words) > 2:
+			parseSQLServerAlterTable(words, tables)
+		case hasPrefixWords(words, "CREATE"):
+			for i, word := range words {
+				if strings.ToUpper(word) == "INDEX" {
+					parseSQLServerCreateIndex(words, i, statement, tables)
+					break
+				}
+			}
+		}
+	}
+
+	return tables, nil
+}
+
+func parseSQLServerCreateTable(statement string) (Table, error) {
+
+	open := strings.IndexByte(statement, '(')
+	if open < 0 {
+		return Table{}, nil
+	}
+	end := matchingParen(statement, open)
+	if end < 0 {
+		return Table{}, fmt.Errorf("unbalanced parentheses in: %s", firstLine(statement))
+	}
+
+	header := strings.Fields(statement[:open])
+	table := newTable(unquoteIdentifier(header[len(header)-1]))
+
+	for _, item := range splitTopLevel(statement[open+1:end], ',') {
+		words := strings.Fields(item)
+		if len(words) == 0 {
+			continue
+		}
+
+		switch {
+		case hasPrefixWords(words, "CONSTRAINT") && len(words) > 2:
+			name := unquoteIdentifier(words[1])
+			rest := strings.TrimSpace(item[strings.Index(item, words[1])+len(words[1]):])
+			parsePostgresTableConstraint(table, name, rest)
+		case hasPrefixWords(words, "PRIMARY", "KEY"),
+			hasPrefixWords(words, "UNIQUE"),
+			hasPrefixWords(words, "FOREIGN", "KEY"),
+			hasPrefixWords(words, "CHECK"),
+			hasPrefixWords(words, "INDEX"):
+			parsePostgresTableConstraint(table, "", item)
+		case len(words) > 1:
+			parseSQLServerColumn(table, item)
+		}
+	}
+
+	return table, nil
+}
+
+func parseSQLServerColumn(table Table, def string) {
+
+	words := strings.Fields(def)
+	name := unquoteIdentifier(words[0])
+	// the type may hold spaces, as SSMS writes [decimal](18, 2)
+	def = strings.TrimSpace(def[strings.Index(def, words[0])+len(words[0]):])
+	columnType := leadingValue(def)
+	words = strings.Fields(def[len(columnType):])
+	columnType = strings.Join(strings.Fields(strings.NewReplacer("[", "", "]", "").Replace(columnType)), "")
+	columnType = NormalizeType(columnType, sqlServerTypeAliases)
+
+	// SQL Server columns are nullable unless stated otherwise, a bare NULL is noise
+	var rest []string
+	for i, word := range words {
+		if strings.ToUpper(word) == "NULL" && (i == 0 || strings.ToUpper(words[i-1]) != "NOT") {
+			continue
+		}
+		rest = append(rest, word)
+	}
+
+	other := strings.Join(rest, " ")
+	if identityClause.MatchString(other) {
+		other = autoIncrementOther(identityClause.ReplaceAllString(other, ""))
+	}
+	table.Columns[name] = newColumn(name, columnType, other, len(table.Columns))
+}
+
+func parseSQLServerAlterTable(words []string, tables map[string]Table) {
+
+	table, exists := tables[unquoteIdentifier(words[2])]
+	if !exists {
+		return
+	}
+
+	add := -1
+	for i, word := range words {
+		if strings.ToUpper(word) == "ADD" {
+			add = i
+			break
+		}
+	}
+	if add < 0 || !hasPrefixWords(words[add:], "ADD", "CONSTRAINT") || add+3 >= len(words) {
+		return
+	}
+	name := unquoteIdentifier(words[add+2])
+	rest := words[add+3:]
+
+	// ADD CONSTRAINT [DF_users_active] DEFAULT ((1)) FOR [active]
+	if hasPrefixWords(rest, "DEFAULT") && len(rest) > 3 && strings.ToUpper(rest[len(rest)-2]) == "FOR" {
+		column, exists := table.Columns[unquoteIdentifier(rest[len(rest)-1])]
+		if !exists {
+			return
+		}
+		other := strings.TrimSpace(column.Other + " " + strings.Join(rest[:len(rest)-2], " "))
+		table.Columns[column.Name] = newColumn(column.Name, column.Type, other, column.Ordinal)
+		return
+	}
+
+	parsePostgresTableConstraint(table, name, strings.Join(rest, " "))
+}
+
+func parseSQLServerCreateIndex(words []string, index int, statement string, tables map[string]Table) {
+
+	if index+3 >= len(words) || strings.ToUpper(words[index+2]) != "ON" {
+		return
+	}
+	name := unquoteIdentifier(words[index+1])
+	tableName := strings.SplitN(words[index+3], "(", 2)[0]
+
+	table, exists := tables[unquoteIdentifier(tableName)]
+	if !exists {
+		return
+	}
+
+	columns, _ := parenList(statement[strings.Index(statement, words[index+3]):])
+	columnName := joinColumnNames(columns)
+
+	if hasPrefixWords(words[1:], "UNIQUE") {
+		addConstraint(table, Constraint{
+			Name:       name,
+			ColumnName: columnName,
+			Type:       "UNIQUE",
+		})
+		return
+	}
+
+	table.Indexes[columnName] = Index{
+		Name:       name,
+		ColumnName: columnName,
+	}
+}
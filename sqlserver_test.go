@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestSQLServerColumnTypes(t *testing.T) {
+
+	dump := "CREATE TABLE [dbo].[orders](\n" +
+		"\t[id] [int] IDENTITY(1,1) NOT NULL,\n" +
+		"\t[total] [decimal](18, 2) NOT NULL,\n" +
+		"\t[rate] [numeric](5, 4) NULL,\n" +
+		"\t[name] [nvarchar](max) NULL,\n" +
+		" CONSTRAINT [PK_orders] PRIMARY KEY CLUSTERED ([id] ASC)\n" +
+		") ON [PRIMARY]\n"
+
+	tables, err := ParseSQLServerDump(dump)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	table, exists := tables["orders"]
+	if !exists {
+		t.Fatalf("table orders not parsed, got %v", tables)
+	}
+
+	tests := []struct {
+		column     string
+		columnType string
+		nullable   bool
+	}{
+		{"id", "int", false},
+		{"total", "decimal(18,2)", false},
+		{"rate", "numeric(5,4)", true},
+		{"name", "longtext", true},
+	}
+
+	for _, tt := range tests {
+		column, exists := table.Columns[tt.column]
+		if !exists {
+			t.Errorf("column %s not parsed", tt.column)
+			continue
+		}
+		if column.Type != tt.columnType || column.Nullable != tt.nullable {
+			t.Errorf("column %s: got type %q nullable %v, want %q %v", tt.column, column.Type, column.Nullable, tt.columnType, tt.nullable)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"strings"
+)
+
+// splitStatements splits a SQL script on the semicolons that end each
+// statement, skipping comments and semicolons inside quotes or dollar-quoted
+// bodies.
+func splitStatements(data string) []string {
+	return splitStatementsOn(data, ";")
+}
+
+// splitMySQLStatements splits a MySQL script the way the mysql client runs
+// it: the contents of versioned comments, /*!50003 ... */, are part of the
+// statements and DELIMITER lines change what ends a statement, which
+// mysqldump does around trigger and routine bodies.
+func splitMySQLStatements(data string) []string {
+
+	var splitter mysqlStatementSplitter
+	var statements []string
+	for _, line := range strings.Split(data, "\n") {
+		statements = append(statements, splitter.line(line)...)
+	}
+	return append(statements, splitter.flush()...)
+}
+
+// mysqlStatementSplitter splits a MySQL script like splitMySQLStatements as
+// it is read line by line, holding no more than the statement being read.
+type mysqlStatementSplitter struct {
+	delimiter string
+	chunk     strings.Builder
+}
+
+// line adds a line of the script, without its newline, and returns the
+// statements it ends.
+func (s *mysqlStatementSplitter) line(line string) []string {
+
+	trimmed := strings.TrimSpace(line)
+	// checked before splitting the line into words, which would be slow
+	// on the long INSERT lines of dumps
+	if len(trimmed) > len("DELIMITER") && strings.EqualFold(trimmed[:len("DELIMITER")], "DELIMITER") {
+		if words := strings.Fields(trimmed); len(words) == 2 && strings.ToUpper(words[0]) == "DELIMITER" {
+			statements := s.flush()
+			s.delimiter = words[1]
+			return statements
+		}
+	}
+
+	s.chunk.WriteString(line)
+	s.chunk.WriteByte('\n')
+	if !strings.HasSuffix(trimmed, s.delim()) {
+		return nil
+	}
+	chunk := s.chunk.String()
+	if _, inQuote := nesting(chunk); inQuote || strings.Count(chunk, "/*") > strings.Count(chunk, "*/") {
+		return nil
+	}
+	return s.flush()
+}
+
+// flush returns the statements read since the last one ended.
+func (s *mysqlStatementSplitter) flush() []string {
+	data := versionedComment.ReplaceAllString(s.chunk.String(), "$1 ")
+	s.chunk.Reset()
+	return splitStatementsOn(data, s.delim())
+}
+
+func (s *mysqlStatementSplitter) delim() string {
+	if s.delimiter == "" {
+		return ";"
+	}
+	return s.delimiter
+}
+
+func splitStatementsOn(data string, delimiter string) []string {
+
+	var statements []string
+	var current strings.Builder
+
+	flush := func() {
+		statement := strings.TrimSpace(current.String())
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		switch {
+		case c == '-' && strings.HasPrefix(data[i:], "--"):
+			end := strings.IndexByte(data[i:], '\n')
+			if end < 0 {
+				i = len(data)
+				continue
+			}
+			i += end
+			current.WriteByte('\n')
+		case c == '/' && strings.HasPrefix(data[i:], "/*"):
+			end := strings.Index(data[i+2:], "*/")
+			if end < 0 {
+				i = len(data)
+				continue
+			}
+			i += end + 3
+			current.WriteByte(' ')
+		case c == '\'' || c == '"' || c == '`':
+			end := closingQuote(data, i)
+			current.WriteString(data[i:end])
+			i = end - 1
+		case strings.HasPrefix(data[i:], delimiter):
+			flush()
+			i += len(delimiter) - 1
+		case c == '$':
+			tag := dollarTag(data[i:])
+			if tag == "" {
+				current.WriteByte(c)
+				continue
+			}
+			end := strings.Index(data[i+len(tag):], tag)
+			if end < 0 {
+				current.WriteString(data[i:])
+				i = len(data)
+				continue
+			}
+			end = i + len(tag) + end + len(tag)
+			current.WriteString(data[i:end])
+			i = end - 1
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+
+	return statements
+}
+
+// closingQuote returns the index right after the quote closing the one
+// opened at start. Doubled quotes and backslash escapes are skipped.
+func closingQuote(data string, start int) int {
+	quote := data[start]
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			if quote == '\'' {
+				i++
+			}
+		case quote:
+			if i+1 < len(data) && data[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(data)
+}
+
+func dollarTag(data string) string {
+	for i := 1; i < len(data); i++ {
+		c := data[i]
+		if c == '$' {
+			return data[:i+1]
+		}
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 1 && c >= '0' && c <= '9') {
+			return ""
+		}
+	}
+	return ""
+}
+
+// splitTopLevel splits s on sep, ignoring separators nested in parentheses
+// or quotes.
+func splitTopLevel(s string, sep byte) []string {
+
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = closingQuote(s, i) - 1
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	parts = append(parts, strings.TrimSpace(s[start:]))
+
+	return parts
+}
+
+// matchingParen returns the index of the parenthesis closing the one at open.
+func matchingParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = closingQuote(s, i) - 1
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// hasPrefixWords reports whether the upper-cased words start with prefix.
+func hasPrefixWords(words []string, prefix ...string) bool {
+	if len(words) < len(prefix) {
+		return false
+	}
+	for i, word := range prefix {
+		if strings.ToUpper(words[i]) != word {
+			return false
+		}
+	}
+	return true
+}
+
+// unquoteIdentifier strips the schema prefix and the quotes of a possibly
+// qualified identifier, e.g. public."users" becomes users.
+func unquoteIdentifier(name string) string {
+	parts := splitTopLevel(name, '.')
+	name = parts[len(parts)-1]
+	return strings.Trim(name, "`\"[]")
+}
+
+// parenList returns the comma separated identifiers between the first pair
+// of parentheses of s, unquoted, and the rest of s after the closing one.
+func parenList(s string) ([]string, string) {
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		return nil, s
+	}
+	end := matchingParen(s, open)
+	if end < 0 {
+		return nil, s
+	}
+
+	var names []string
+	for _, name := range splitTopLevel(s[open+1:end], ',') {
+		names = append(names, unquoteIdentifier(trimSortOrder(name)))
+	}
+	return names, strings.TrimSpace(s[end+1:])
+}
+
+// trimSortOrder drops the ASC or DESC following a column in an index
+// definition.
+func trimSortOrder(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 2 {
+		switch strings.ToUpper(words[1]) {
+		case "ASC", "DESC":
+			return words[0]
+		}
+	}
+	return name
+}
+
+// joinColumnNames joins column names the way the MySQL parser stores
+// composite keys, so keys parsed from every dialect compare equal.
+func joinColumnNames(names []string) string {
+	return strings.Join(names, "`,`")
+}
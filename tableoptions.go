@@ -0,0 +1,106 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	encryptionPattern      = regexp.MustCompile(`(?i)\bENCRYPTION\s*=\s*'([YN])'`)
+	statsPersistentPattern = regexp.MustCompile(`(?i)\bSTATS_PERSISTENT\s*=\s*(0|1|DEFAULT)\b`)
+	statsAutoRecalcPattern = regexp.MustCompile(`(?i)\bSTATS_AUTO_RECALC\s*=\s*(0|1|DEFAULT)\b`)
+	// DEFAULT CHARSET=utf8mb4 on a table, CHARACTER SET latin1 on a column
+	charsetPattern = regexp.MustCompile(`(?i)\b(?:CHARSET|CHARACTER\s+SET)\s*=?\s*(\w+)`)
+	// the AUTO_INCREMENT=N counter is not part of the table definition
+	autoIncrementCounterPattern = regexp.MustCompile(`(?i)\bAUTO_INCREMENT\s*=\s*\d+`)
+)
+
+// parseTableOptions reads the options of the line closing a CREATE TABLE,
+// e.g. ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 ENCRYPTION='Y';
+func parseTableOptions(line string) TableOptions {
+	options := applyTableOption(TableOptions{}, line)
+	other := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(line), ")"), ";")
+	for _, pattern := range []*regexp.Regexp{encryptionPattern, statsPersistentPattern, statsAutoRecalcPattern, autoIncrementCounterPattern} {
+		other = pattern.ReplaceAllString(other, "")
+	}
+	options.Other = strings.Join(strings.Fields(other), " ")
+	return options
+}
+
+// charsetOption returns the character set of a table or column definition,
+// lowercase, or "" when it has none.
+func charsetOption(definition string) string {
+	if m := charsetPattern.FindStringSubmatch(definition); m != nil {
+		return strings.ToLower(m[1])
+	}
+	return ""
+}
+
+// applyTableOption returns the options changed by a single table option of
+// an ALTER TABLE, the other ones being left as they are.
+func applyTableOption(options TableOptions, option string) TableOptions {
+	if m := encryptionPattern.FindStringSubmatch(option); m != nil {
+		options.Encrypted = m[1] == "Y" || m[1] == "y"
+	}
+	if m := statsPersistentPattern.FindStringSubmatch(option); m != nil {
+		options.StatsPersistent = statsValue(m[1])
+	}
+	if m := statsAutoRecalcPattern.FindStringSubmatch(option); m != nil {
+		options.StatsAutoRecalc = statsValue(m[1])
+	}
+	return options
+}
+
+// statsValue returns the setting of a STATS_* option, nil for DEFAULT.
+func statsValue(value string) *bool {
+	if strings.ToUpper(value) == "DEFAULT" {
+		return nil
+	}
+	enabled := value == "1"
+	return &enabled
+}
+
+func encryptionOption(options TableOptions) string {
+	if options.Encrypted {
+		return "ENCRYPTION='Y'"
+	}
+	return "ENCRYPTION='N'"
+}
+
+// statsOption renders a STATS_* option, DEFAULT when it is not set.
+func statsOption(name string, value *bool) string {
+	switch {
+	case value == nil:
+		return name + "=DEFAULT"
+	case *value:
+		return name + "=1"
+	}
+	return name + "=0"
+}
+
+func sameStatsOption(a *bool, b *bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// tableOptionsClause renders the options as written and the compared ones
+// that differ from the defaults, as they follow the closing parenthesis of a
+// CREATE TABLE.
+func tableOptionsClause(options TableOptions) string {
+	var clauses []string
+	if options.Other != "" {
+		clauses = append(clauses, options.Other)
+	}
+	if options.Encrypted {
+		clauses = append(clauses, encryptionOption(options))
+	}
+	if options.StatsPersistent != nil {
+		clauses = append(clauses, statsOption("STATS_PERSISTENT", options.StatsPersistent))
+	}
+	if options.StatsAutoRecalc != nil {
+		clauses = append(clauses, statsOption("STATS_AUTO_RECALC", options.StatsAutoRecalc))
+	}
+	return strings.Join(clauses, " ")
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type planAttr struct {
+	Sign string
+	Key  string
+	A    string
+	B    string
+}
+
+type planBlock struct {
+	Sign  string
+	Kind  string
+	Name  string
+	Attrs []planAttr
+}
+
+type tablePlan struct {
+	Sign   string
+	Name   string
+	Attrs  []planAttr
+	Blocks []*planBlock
+}
+
+// renderTerraform renders the diffs the way terraform plan shows changes:
+// "-" for objects only in A, "+" for objects only in B and "~" for objects
+// modified in place.
+func renderTerraform(diffs []Diff, tablesA map[string]Table, tablesB map[string]Table, opts CompareOptions, color bool) string {
+
+	plans := make(map[string]*tablePlan)
+	planFor := func(tableName string) *tablePlan {
+		if plans[tableName] == nil {
+			plans[tableName] = &tablePlan{Sign: "~", Name: tableName}
+		}
+		return plans[tableName]
+	}
+
+	renamedTo := make(map[string]bool)
+	for _, d := range diffs {
+		switch d.Type {
+		case RenamedTable:
+			renamedTo[d.B] = true
+		case RenamedColumn:
+			renamedTo[d.Target+"."+d.B] = true
+		}
+		addToPlan(planFor, d, "-", tablesA)
+	}
+
+	// The diffs only go from A to B, objects only in B come from comparing
+	// the other way around.
+	for _, d := range compareTables(tablesB, tablesA, opts) {
+		if d.Type == MissingTable && renamedTo[d.A] || d.Type == MissingColumn && renamedTo[d.Target+"."+d.A] {
+			continue
+		}
+		if strings.HasPrefix(d.Type, "MISSING_") {
+			addToPlan(planFor, d, "+", tablesB)
+		}
+	}
+
+	tableNames := make([]string, 0, len(plans))
+	for tableName := range plans {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
+	paint := func(sign string) string {
+		if !color {
+			return sign
+		}
+		switch sign {
+		case "+":
+			return sgr(colorGreen, sign)
+		case "-":
+			return sgr(colorRed, sign)
+		}
+		return sgr(colorYellow, sign)
+	}
+
+	var b strings.Builder
+	counts := make(map[string]int)
+	b.WriteString("SQLCompare will perform the following actions:\n")
+	for _, tableName := range tableNames {
+		plan := plans[tableName]
+		counts[plan.Sign]++
+
+		fmt.Fprintf(&b, "\n  # table.%s %s\n", plan.Name, planAction(plan.Sign))
+		fmt.Fprintf(&b, "  %s table %s {\n", paint(plan.Sign), strconv.Quote(plan.Name))
+		writeAttrs(&b, "      ", plan.Attrs, paint)
+		for _, block := range plan.Blocks {
+			fmt.Fprintf(&b, "      %s %s %s {\n", paint(block.Sign), block.Kind, strconv.Quote(block.Name))
+			writeAttrs(&b, "          ", block.Attrs, paint)
+			b.WriteString("        }\n")
+		}
+		b.WriteString("    }\n")
+	}
+
+	fmt.Fprintf(&b, "\nPlan: %d to add, %d to change, %d to destroy.\n", counts["+"], counts["~"], counts["-"])
+	return b.String()
+}
+
+func writeAttrs(b *strings.Builder, indent string, attrs []planAttr, paint func(string) string) {
+
+	width := 0
+	for _, attr := range attrs {
+		if len(attr.Key) > width {
+			width = len(attr.Key)
+		}
+	}
+
+	for _, attr := range attrs {
+		value := strconv.Quote(attr.A)
+		switch attr.Sign {
+		case "+":
+			value = strconv.Quote(attr.B)
+		case "~":
+			value += " -> " + strconv.Quote(attr.B)
+		}
+		fmt.Fprintf(b, "%s%s %-*s = %s\n", indent, paint(attr.Sign), width, attr.Key, value)
+	}
+}
+
+// addToPlan adds a diff to the plan of its table. sign is the one used for
+// MISSING_* diffs, whose objects are looked up in tables.
+func addToPlan(planFor func(string) *tablePlan, d Diff, sign string, tables map[string]Table) {
+
+	blockFor := func(plan *tablePlan, blockSign string, kind string, name string) *planBlock {
+		for _, block := range plan.Blocks {
+			if block.Kind == kind && block.Name == name {
+				return block
+			}
+		}
+		block := &planBlock{Sign: blockSign, Kind: kind, Name: name}
+		plan.Blocks = append(plan.Blocks, block)
+		return block
+	}
+
+	switch d.Type {
+	case MissingTable:
+		plan := planFor(d.A)
+		plan.Sign = sign
+		table := tables[d.A]
+		for _, column := range table.SortedColumns() {
+			blockFor(plan, sign, "column", column.Name).Attrs = columnAttrs(sign, column)
+		}
+	case RenamedTable:
+		plan := planFor(d.A)
+		plan.Attrs = append(plan.Attrs, planAttr{Sign: "~", Key: "name", A: d.A, B: d.B})
+	case WrongTableEncryption:
+		plan := planFor(d.Target)
+		plan.Attrs = append(plan.Attrs, planAttr{Sign: "~", Key: "encryption", A: d.A, B: d.B})
+	case WrongTableStatsOption:
+		plan := planFor(d.Target)
+		key := strings.ToLower(d.A[:strings.IndexByte(d.A, '=')])
+		plan.Attrs = append(plan.Attrs, planAttr{Sign: "~", Key: key, A: d.A, B: d.B})
+	case RenamedColumn:
+		block := blockFor(planFor(d.Target), "~", "column", d.A)
+		block.Attrs = append(block.Attrs, planAttr{Sign: "~", Key: "name", A: d.A, B: d.B})
+	case MissingColumn:
+		column := tables[d.Target].Columns[d.A]
+		blockFor(planFor(d.Target), sign, "column", d.A).Attrs = columnAttrs(sign, column)
+	case MissingIndex:
+		tableName, columnName := splitTarget(d.Target)
+		block := blockFor(planFor(tableName), sign, "index", d.A)
+		block.Attrs = append(block.Attrs, planAttr{Sign: sign, Key: "columns", A: columnName, B: columnName})
+	case WrongIndexName:
+		tableName, _ := splitTarget(d.Target)
+		block := blockFor(planFor(tableName), "~", "index", d.A)
+		block.Attrs = append(block.Attrs, planAttr{Sign: "~", Key: "name", A: d.A, B: d.B})
+	case WrongIndexKind, WrongIndexPrefixLength:
+		tableName, columnName := splitTarget(d.Target)
+		key := map[string]string{WrongIndexKind: "kind", WrongIndexPrefixLength: "prefix_length"}[d.Type]
+		block := blockFor(planFor(tableName), "~", "index", tables[tableName].Indexes[columnName].Name)
+		block.Attrs = append(block.Attrs, planAttr{Sign: "~", Key: key, A: d.A, B: d.B})
+	case MissingConstraint:
+		tableName, columnName := splitTarget(d.Target)
+		constraint := tables[tableName].Constraints[columnName][d.A]
+		block := blockFor(planFor(tableName), sign, "constraint", constraint.Name)
+		block.Attrs = append(block.Attrs,
+			planAttr{Sign: sign, Key: "type", A: constraint.Type, B: constraint.Type},
+			planAttr{Sign: sign, Key: "columns", A: columnName, B: columnName})
+		if constraint.Other != "" {
+			block.Attrs = append(block.Attrs, planAttr{Sign: sign, Key: "other", A: constraint.Other, B: constraint.Other})
+		}
+	case WrongColumnType, WrongColumnOther, WrongColumnInvisibility, WrongColumnFormat, NullableToNotNullWithoutDefault:
+		tableName, columnName := splitTarget(d.Target)
+		key := map[string]string{WrongColumnType: "type", WrongColumnOther: "other", WrongColumnInvisibility: "visibility", WrongColumnFormat: "column_format", NullableToNotNullWithoutDefault: "null"}[d.Type]
+		block := blockFor(planFor(tableName), "~", "column", columnName)
+		block.Attrs = append(block.Attrs, planAttr{Sign: "~", Key: key, A: d.A, B: d.B})
+	case WrongSetValues:
+		tableName, columnName := splitTarget(d.Target)
+		block := blockFor(planFor(tableName), "~", "column", columnName)
+		for _, value := range splitTopLevel(d.A, ',') {
+			if value != "" {
+				block.Attrs = append(block.Attrs, planAttr{Sign: "-", Key: "set_value", A: value})
+			}
+		}
+		for _, value := range splitTopLevel(d.B, ',') {
+			if value != "" {
+				block.Attrs = append(block.Attrs, planAttr{Sign: "+", Key: "set_value", B: value})
+			}
+		}
+	case WrongConstraintOther, WrongFKMatchType:
+		column := d.Target[:strings.LastIndex(d.Target, ".")]
+		constraintType := d.Target[strings.LastIndex(d.Target, ".")+1:]
+		tableName, columnName := splitTarget(column)
+		key := map[string]string{WrongConstraintOther: "other", WrongFKMatchType: "match"}[d.Type]
+		constraint := tables[tableName].Constraints[columnName][constraintType]
+		block := blockFor(planFor(tableName), "~", "constraint", constraint.Name)
+		block.Attrs = append(block.Attrs, planAttr{Sign: "~", Key: key, A: d.A, B: d.B})
+	}
+}
+
+func columnAttrs(sign string, column Column) []planAttr {
+	attrs := []planAttr{{Sign: sign, Key: "type", A: column.Type, B: column.Type}}
+	if column.Other != "" {
+		attrs = append(attrs, planAttr{Sign: sign, Key: "other", A: column.Other, B: column.Other})
+	}
+	return attrs
+}
+
+func planAction(sign string) string {
+	switch sign {
+	case "+":
+		return "will be created"
+	case "-":
+		return "will be destroyed"
+	}
+	return "will be updated in-place"
+}
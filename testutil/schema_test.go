@@ -0,0 +1,54 @@
+package testutil
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGenerateSchemaDeterministic(t *testing.T) {
+
+	a := GenerateSchema(50, 10, rand.New(rand.NewSource(42)))
+	b := GenerateSchema(50, 10, rand.New(rand.NewSource(42)))
+	if a != b {
+		t.Error("the same seed generated different schemas")
+	}
+	if c := GenerateSchema(50, 10, rand.New(rand.NewSource(43))); c == a {
+		t.Error("different seeds generated the same schema")
+	}
+}
+
+func TestGenerateSchemaShape(t *testing.T) {
+
+	tests := []struct {
+		numTables       int
+		maxColsPerTable int
+	}{
+		{0, 5},
+		{1, 1},
+		{20, 8},
+		// maxColsPerTable below 1 still gives every table a column
+		{5, 0},
+	}
+
+	for _, tt := range tests {
+		schema := GenerateSchema(tt.numTables, tt.maxColsPerTable, rand.New(rand.NewSource(1)))
+
+		if got := strings.Count(schema, "CREATE TABLE "); got != tt.numTables {
+			t.Errorf("GenerateSchema(%d, %d): %d tables", tt.numTables, tt.maxColsPerTable, got)
+		}
+		maxCols := tt.maxColsPerTable
+		if maxCols < 1 {
+			maxCols = 1
+		}
+		for _, table := range strings.Split(schema, "CREATE TABLE ")[1:] {
+			columns := strings.Count(table, "  `col_")
+			if columns < 1 || columns > maxCols {
+				t.Errorf("GenerateSchema(%d, %d): table with %d columns:\n%s", tt.numTables, tt.maxColsPerTable, columns, table)
+			}
+			if !strings.Contains(table, "PRIMARY KEY (`id`)") {
+				t.Errorf("GenerateSchema(%d, %d): table without a primary key:\n%s", tt.numTables, tt.maxColsPerTable, table)
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var createTrigger = regexp.MustCompile(`(?is)^CREATE\s+(?:DEFINER\s*=\s*\S+\s+)?TRIGGER\s+(?:IF\s+NOT\s+EXISTS\s+)?(\S+)\s+(BEFORE|AFTER)\s+(INSERT|UPDATE|DELETE)\s+ON\s+(\S+)\s+FOR\s+EACH\s+ROW\s+(?:(?:FOLLOWS|PRECEDES)\s+\S+\s+)?(.*)$`)
+
+type Trigger struct {
+	Name string
+	// BEFORE or AFTER
+	Timing string
+	// INSERT, UPDATE or DELETE
+	Event string
+	Table string
+	// the statement run for each row, whitespace-normalized
+	Body string
+}
+
+// parseTrigger parses a CREATE TRIGGER statement of a MySQL schema,
+// including the ones mysqldump writes between DELIMITER lines. It returns
+// false for other statements.
+func parseTrigger(statement string, schemaPrefix string) (Trigger, bool) {
+
+	match := createTrigger.FindStringSubmatch(statement)
+	if match == nil {
+		return Trigger{}, false
+	}
+	return Trigger{
+		Name:   mysqlTableName(match[1], schemaPrefix),
+		Timing: strings.ToUpper(match[2]),
+		Event:  strings.ToUpper(match[3]),
+		Table:  mysqlTableName(match[4], schemaPrefix),
+		Body:   normalizeExpression(match[5]),
+	}, true
+}
+
+// compareTriggers reports the triggers of triggerMapA missing from
+// triggerMapB and those whose body, timing or event differs. Triggers are
+// filtered by the name of their table.
+func compareTriggers(triggerMapA map[string]Trigger, triggerMapB map[string]Trigger, opts CompareOptions) []Diff {
+
+	names := make([]string, 0, len(triggerMapA))
+	for name, trigger := range triggerMapA {
+		if includesTable(trigger.Table, opts) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diffs := make([]Diff, 0)
+	for _, name := range names {
+		triggerA := triggerMapA[name]
+		triggerB, exists := triggerMapB[name]
+		target := triggerA.Table + "." + name
+
+		var d Diff
+		switch {
+		case !exists:
+			d = Diff{Type: MissingTrigger, Target: target, A: name, B: ""}
+		case triggerA.Timing != triggerB.Timing || triggerA.Event != triggerB.Event || triggerA.Table != triggerB.Table:
+			d = Diff{Type: WrongTriggerBody, Target: target, A: triggerDefinition(triggerA), B: triggerDefinition(triggerB)}
+		case triggerA.Body != triggerB.Body:
+			d = Diff{Type: WrongTriggerBody, Target: target, A: triggerA.Body, B: triggerB.Body}
+		default:
+			continue
+		}
+		d.Severity = classifyDiff(d)
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// triggerDefinition renders what follows the trigger name in its CREATE
+// TRIGGER, for diffs where more than the body changed.
+func triggerDefinition(t Trigger) string {
+	return t.Timing + " " + t.Event + " ON `" + t.Table + "` FOR EACH ROW " + t.Body
+}
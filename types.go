@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+)
+
+// NormalizeType lower-cases a column type and rewrites it through an alias
+// table. The whole type is looked up first, so an alias can drop the length
+// (datetime2(7)), then its base name, keeping the length (nvarchar(50)).
+func NormalizeType(columnType string, aliases map[string]string) string {
+	lower := strings.ToLower(columnType)
+	if alias, ok := aliases[lower]; ok {
+		return alias
+	}
+
+	base, args := lower, ""
+	if i := strings.IndexByte(lower, '('); i >= 0 {
+		base, args = lower[:i], lower[i:]
+	}
+	if alias, ok := aliases[base]; ok {
+		return alias + args
+	}
+	return lower
+}
+
+// MySQL type names that are synonyms of another type
+var mysqlTypeAliases = map[string]string{
+	"integer":   "int",
+	"int1":      "tinyint",
+	"int2":      "smallint",
+	"int3":      "mediumint",
+	"middleint": "mediumint",
+	"int4":      "int",
+	"int8":      "bigint",
+	"bool":      "tinyint(1)",
+	"boolean":   "tinyint(1)",
+	"dec":       "decimal",
+	"numeric":   "decimal",
+	"fixed":     "decimal",
+	"real":      "double",
+	"float8":    "double",
+	"float4":    "float",
+	"character": "char",
+}
+
+// aliasedType rewrites a MySQL type name through mysqlTypeAliases for
+// comparison. Unlike NormalizeType only the name is lower-cased, the quoted
+// ENUM and SET values keep their case.
+func aliasedType(columnType string) string {
+	base, args := columnType, ""
+	if i := strings.IndexByte(columnType, '('); i >= 0 {
+		base, args = columnType[:i], columnType[i:]
+	}
+	base = strings.ToLower(base)
+
+	if alias, ok := mysqlTypeAliases[base+strings.ToLower(args)]; ok {
+		return alias
+	}
+	if alias, ok := mysqlTypeAliases[base]; ok {
+		return alias + args
+	}
+	return base + args
+}
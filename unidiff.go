@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unifiedContext is the number of unchanged lines around each hunk, as in
+// diff -u and git diff.
+const unifiedContext = 3
+
+type diffLine struct {
+	// ' ' for a line in both schemas, '-' for one only in A, '+' for one
+	// only in B
+	op   byte
+	text string
+}
+
+// renderUnifiedDiff renders both schemas as CREATE TABLE statements, the way
+// the migration writes them and in table name order, and returns the unified
+// diff between them, empty when they are the same.
+func renderUnifiedDiff(tablesA map[string]Table, tablesB map[string]Table) string {
+
+	var script []diffLine
+	for _, name := range unionKeys(tablesA, tablesB) {
+		script = append(script, diffLines(tableLines(tablesA, name), tableLines(tablesB, name))...)
+	}
+
+	var b strings.Builder
+	lineA, lineB := 1, 1
+	for start := 0; start < len(script); {
+		if script[start].op == ' ' {
+			start++
+			lineA++
+			lineB++
+			continue
+		}
+
+		// the hunk runs until more than twice the context of unchanged lines
+		end := start
+		for i := start; i < len(script) && i-end <= 2*unifiedContext; i++ {
+			if script[i].op != ' ' {
+				end = i + 1
+			}
+		}
+		from := start - unifiedContext
+		if from < 0 {
+			from = 0
+		}
+		to := end + unifiedContext
+		if to > len(script) {
+			to = len(script)
+		}
+
+		hunkA, hunkB := lineA-(start-from), lineB-(start-from)
+		var countA, countB int
+		var lines strings.Builder
+		for _, line := range script[from:to] {
+			if line.op != '+' {
+				countA++
+			}
+			if line.op != '-' {
+				countB++
+			}
+			lines.WriteByte(line.op)
+			lines.WriteString(line.text)
+			lines.WriteString("\n")
+		}
+
+		if b.Len() == 0 {
+			b.WriteString("--- a/schema.sql\n+++ b/schema.sql\n")
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(hunkA, countA), hunkRange(hunkB, countB))
+		b.WriteString(lines.String())
+
+		for _, line := range script[start:to] {
+			if line.op != '+' {
+				lineA++
+			}
+			if line.op != '-' {
+				lineB++
+			}
+		}
+		start = to
+	}
+	return b.String()
+}
+
+// tableLines returns the lines of the CREATE TABLE of a table followed by a
+// blank line, none when the table does not exist.
+func tableLines(tables map[string]Table, name string) []string {
+	table, exists := tables[name]
+	if !exists {
+		return nil
+	}
+	return append(strings.Split(createTableSQL(table), "\n"), "")
+}
+
+// diffLines returns the shortest edit script turning a into b, from their
+// longest common subsequence of lines.
+func diffLines(a []string, b []string) []diffLine {
+
+	// common[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	var script []diffLine
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			script = append(script, diffLine{' ', a[i]})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && common[i+1][j] >= common[i][j+1]):
+			script = append(script, diffLine{'-', a[i]})
+			i++
+		default:
+			script = append(script, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	return script
+}
+
+// hunkRange renders the start and length of a hunk, the start being the
+// line before it when it is empty.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		start--
+	}
+	if count == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+)
+
+var (
+	// mysqldump wraps views, triggers and routines in versioned comments,
+	// which splitStatements would skip
+	versionedComment = regexp.MustCompile(`(?s)/\*!\d*\s*(.*?)\s*\*/`)
+	createView       = regexp.MustCompile(`(?is)^CREATE\s+(?:OR\s+REPLACE\s+)?(?:ALGORITHM\s*=\s*\w+\s+)?(?:DEFINER\s*=\s*\S+\s+)?(?:SQL\s+SECURITY\s+\w+\s+)?VIEW\s+(\S+?)\s*(\([^)]*\))?\s+AS\s+(.*)$`)
+)
+
+type View struct {
+	Name string
+	// the column list, if any, and the SELECT, whitespace-normalized
+	Definition string
+}
+
+// parseView parses a CREATE VIEW statement of a MySQL schema, either
+// written by hand or by mysqldump, which first creates a placeholder view
+// and replaces it with the real one after all the tables. It returns false
+// for other statements.
+func parseView(statement string, schemaPrefix string) (View, bool) {
+
+	match := createView.FindStringSubmatch(statement)
+	if match == nil {
+		return View{}, false
+	}
+	definition := normalizeExpression(match[3])
+	if match[2] != "" {
+		definition = normalizeExpression(match[2]) + " AS " + definition
+	}
+	name := mysqlTableName(match[1], schemaPrefix)
+	return View{Name: name, Definition: definition}, true
+}
+
+// compareViews reports the views of viewMapA missing from viewMapB and
+// those whose definition differs. Views are filtered by name like tables.
+func compareViews(viewMapA map[string]View, viewMapB map[string]View, opts CompareOptions) []Diff {
+
+	names := make([]string, 0, len(viewMapA))
+	for name := range viewMapA {
+		if includesTable(name, opts) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diffs := make([]Diff, 0)
+	for _, name := range names {
+		viewA := viewMapA[name]
+		viewB, exists := viewMapB[name]
+
+		var d Diff
+		switch {
+		case !exists:
+			d = Diff{Type: MissingView, Target: name, A: name, B: ""}
+		case viewA.Definition != viewB.Definition:
+			d = Diff{Type: WrongViewDefinition, Target: name, A: viewA.Definition, B: viewB.Definition}
+		default:
+			continue
+		}
+		d.Severity = classifyDiff(d)
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	watchInterval = 500 * time.Millisecond
+	clearScreen   = "\033[H\033[2J"
+)
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+	exists  bool
+}
+
+func statFile(path string) fileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{modTime: info.ModTime(), size: info.Size(), exists: true}
+}
+
+// watchFiles calls onChange once and then again every time one of the files
+// is written. Files are polled so it works the same on every platform.
+func watchFiles(paths []string, interval time.Duration, onChange func()) {
+
+	states := make([]fileState, len(paths))
+	for i, path := range paths {
+		states[i] = statFile(path)
+	}
+	onChange()
+
+	for range time.Tick(interval) {
+
+		changed := false
+		for i, path := range paths {
+			state := statFile(path)
+			if state != states[i] {
+				states[i] = state
+				changed = true
+			}
+		}
+
+		if changed {
+			onChange()
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// websocketGUID is appended to the Sec-WebSocket-Key of a handshake to
+// compute its Sec-WebSocket-Accept, RFC 6455 section 1.3.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// frame opcodes, RFC 6455 section 5.2
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// close status codes, RFC 6455 section 7.4.1
+const (
+	closeNormal          = 1000
+	closeProtocolError   = 1002
+	closeInvalidData     = 1007
+	closeMessageTooLarge = 1009
+)
+
+// websocketConn is the server side of a WebSocket connection, RFC 6455,
+// reading the messages of the client and writing JSON messages back.
+type websocketConn struct {
+	conn net.Conn
+	rw   *bufio.ReadWriter
+}
+
+// websocketError is an error after which the connection is closed with
+// its status code.
+type websocketError struct {
+	code   uint16
+	reason string
+}
+
+func (e *websocketError) Error() string {
+	return e.reason
+}
+
+// upgradeWebSocket completes the opening handshake of a WebSocket request
+// and takes over its connection. When r is not a handshake, it writes an
+// error response and returns nil.
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) *websocketConn {
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		writeJSONError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return nil
+	}
+	if !headerHasToken(r.Header, "Connection", "upgrade") || !headerHasToken(r.Header, "Upgrade", "websocket") {
+		writeJSONError(w, http.StatusBadRequest, fmt.Errorf("not a WebSocket handshake"))
+		return nil
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		writeJSONError(w, http.StatusUpgradeRequired, fmt.Errorf("unsupported WebSocket version"))
+		return nil
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		writeJSONError(w, http.StatusBadRequest, fmt.Errorf("missing Sec-WebSocket-Key"))
+		return nil
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, fmt.Errorf("connection cannot be upgraded"))
+		return nil
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	accept := sha1.Sum([]byte(key + websocketGUID))
+	fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n",
+		base64.StdEncoding.EncodeToString(accept[:]))
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil
+	}
+	return &websocketConn{conn: conn, rw: rw}
+}
+
+// headerHasToken reports whether the comma separated values of a header
+// hold token, ignoring case.
+func headerHasToken(header http.Header, name string, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, v := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readMessage returns the next text or binary message of the client, put
+// back together from its fragments, answering the pings in between. It
+// returns io.EOF when the client closes the connection.
+func (c *websocketConn) readMessage(maxSize int64) ([]byte, error) {
+
+	var message []byte
+	started := false
+	for {
+		fin, opcode, payload, err := c.readFrame(maxSize - int64(len(message)))
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			return nil, io.EOF
+		case opText, opBinary:
+			if started {
+				return nil, &websocketError{closeProtocolError, "new message before the end of the previous one"}
+			}
+			started = true
+		case opContinuation:
+			if !started {
+				return nil, &websocketError{closeProtocolError, "continuation frame without a message"}
+			}
+		default:
+			return nil, &websocketError{closeProtocolError, fmt.Sprintf("unknown opcode %d", opcode)}
+		}
+
+		message = append(message, payload...)
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// readFrame reads a frame of the client, which must be masked, and returns
+// its unmasked payload.
+func (c *websocketConn) readFrame(maxSize int64) (bool, byte, []byte, error) {
+
+	var header [2]byte
+	if _, err := io.ReadFull(c.rw, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0f
+	if header[1]&0x80 == 0 {
+		return false, 0, nil, &websocketError{closeProtocolError, "unmasked client frame"}
+	}
+
+	size := uint64(header[1] & 0x7f)
+	switch size {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(c.rw, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		size = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(c.rw, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		size = binary.BigEndian.Uint64(extended[:])
+	}
+	if size > uint64(maxSize) {
+		return false, 0, nil, &websocketError{closeMessageTooLarge, "message too large"}
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.rw, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(c.rw, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return fin, opcode, payload, nil
+}
+
+// writeFrame writes an unmasked frame holding a whole message.
+func (c *websocketConn) writeFrame(opcode byte, payload []byte) error {
+
+	header := []byte{0x80 | opcode}
+	switch size := len(payload); {
+	case size < 126:
+		header = append(header, byte(size))
+	case size <= 0xffff:
+		header = append(header, 126, byte(size>>8), byte(size))
+	default:
+		header = append(header, 127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(size))
+	}
+
+	if _, err := c.rw.Write(header); err != nil {
+		return err
+	}
+	if _, err := c.rw.Write(payload); err != nil {
+		return err
+	}
+	return c.rw.Flush()
+}
+
+// writeJSON sends v as a text message.
+func (c *websocketConn) writeJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.writeFrame(opText, data)
+}
+
+// close sends a close frame with code and reason and closes the connection.
+func (c *websocketConn) close(code uint16, reason string) error {
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, code)
+	payload = append(payload, reason...)
+	err := c.writeFrame(opClose, payload)
+	if closeErr := c.conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlSchema is a schema written as YAML by schema-as-code migration tools.
+type yamlSchema struct {
+	Tables []yamlTable `yaml:"tables"`
+}
+
+type yamlTable struct {
+	Name        string           `yaml:"name"`
+	Columns     []yamlColumn     `yaml:"columns"`
+	Indexes     []yamlIndex      `yaml:"indexes"`
+	ForeignKeys []yamlForeignKey `yaml:"foreign_keys"`
+}
+
+type yamlColumn struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"`
+	// nil when left out, a column being nullable unless it is in the
+	// primary key
+	Nullable      *bool  `yaml:"nullable"`
+	Default       string `yaml:"default"`
+	Primary       bool   `yaml:"primary"`
+	Unique        bool   `yaml:"unique"`
+	AutoIncrement bool   `yaml:"auto_increment"`
+	Other         string `yaml:"other"`
+}
+
+type yamlIndex struct {
+	Name    string   `yaml:"name"`
+	Columns []string `yaml:"columns"`
+	Unique  bool     `yaml:"unique"`
+	// FULLTEXT or SPATIAL, empty for a regular index
+	Kind string `yaml:"kind"`
+}
+
+type yamlForeignKey struct {
+	Name       string   `yaml:"name"`
+	Columns    []string `yaml:"columns"`
+	References struct {
+		Table   string   `yaml:"table"`
+		Columns []string `yaml:"columns"`
+	} `yaml:"references"`
+	OnDelete string `yaml:"on_delete"`
+	OnUpdate string `yaml:"on_update"`
+}
+
+// ParseYAMLSchema reads the tables of a schema written in YAML instead of
+// DDL, as some migration tools do:
+//
+//	tables:
+//	  - name: users
+//	    columns:
+//	      - {name: id, type: INT, primary: true, auto_increment: true}
+//	      - {name: email, type: VARCHAR(255), nullable: false, unique: true}
+//	      - {name: status, type: VARCHAR(16), default: "'active'"}
+//	    indexes:
+//	      - {name: idx_status, columns: [status]}
+//	  - name: orders
+//	    columns:
+//	      - {name: id, type: BIGINT, primary: true}
+//	      - {name: user_id, type: INT}
+//	    foreign_keys:
+//	      - name: fk_orders_user
+//	        columns: [user_id]
+//	        references: {table: users, columns: [id]}
+//	        on_delete: CASCADE
+//
+// A column is nullable unless nullable is false or it is in the primary
+// key, made of the columns marked primary. default is written into the
+// definition as is, so string defaults keep their quotes, and other holds
+// any further attributes, e.g. COMMENT 'x'. A unique column gets a unique
+// key named after it, and an index with unique: true is a unique key. kind
+// is FULLTEXT or SPATIAL for those indexes. Like InnoDB, a foreign key
+// whose columns have no index gets one named after it.
+func ParseYAMLSchema(data []byte) (map[string]Table, error) {
+
+	var schema yamlSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string]Table)
+	for _, def := range schema.Tables {
+		if def.Name == "" {
+			return nil, fmt.Errorf("table without a name")
+		}
+		table := newTable(def.Name)
+
+		var primary []string
+		for _, column := range def.Columns {
+			if column.Name == "" {
+				return nil, fmt.Errorf("%s: column without a name", def.Name)
+			}
+			table.Columns[column.Name] = newColumn(column.Name, column.Type, yamlColumnOther(column), len(table.Columns))
+			if column.Primary {
+				primary = append(primary, column.Name)
+			}
+			if column.Unique {
+				addConstraint(table, Constraint{Name: column.Name, ColumnName: column.Name, Type: "UNIQUE"})
+			}
+		}
+		if len(primary) > 0 {
+			addConstraint(table, Constraint{Name: "PRIMARY", ColumnName: metadataColumnName("", primary), Type: "PRIMARY"})
+		}
+
+		for _, index := range def.Indexes {
+			columnName := metadataColumnName("", index.Columns)
+			if index.Unique {
+				addConstraint(table, Constraint{Name: index.Name, ColumnName: columnName, Type: "UNIQUE"})
+				continue
+			}
+			table.Indexes[columnName] = Index{Name: index.Name, ColumnName: columnName, Kind: IndexKind(strings.ToUpper(index.Kind))}
+		}
+
+		for _, fk := range def.ForeignKeys {
+			other := fmt.Sprintf("REFERENCES `%s` %s", fk.References.Table, quoteColumns(strings.Join(fk.References.Columns, ",")))
+			if fk.OnDelete != "" {
+				other += " ON DELETE " + strings.ToUpper(fk.OnDelete)
+			}
+			if fk.OnUpdate != "" {
+				other += " ON UPDATE " + strings.ToUpper(fk.OnUpdate)
+			}
+			columnName := metadataColumnName("", fk.Columns)
+			addConstraint(table, Constraint{Name: fk.Name, ColumnName: columnName, Type: "FOREIGN", Other: other})
+
+			// like InnoDB, index the columns of a foreign key that no key
+			// covers, under the name of the foreign key
+			_, indexed := table.Indexes[columnName]
+			_, primary := table.Constraints[columnName]["PRIMARY"]
+			_, unique := table.Constraints[columnName]["UNIQUE"]
+			if !indexed && !primary && !unique {
+				table.Indexes[columnName] = Index{Name: fk.Name, ColumnName: columnName}
+			}
+		}
+		tables[def.Name] = table
+	}
+	return tables, nil
+}
+
+// yamlColumnOther renders the attributes of a YAML column the way they
+// follow the type in SHOW CREATE TABLE.
+func yamlColumnOther(column yamlColumn) string {
+	var attributes []string
+	if column.Primary || (column.Nullable != nil && !*column.Nullable) {
+		attributes = append(attributes, "NOT NULL")
+	}
+	if column.Default != "" {
+		attributes = append(attributes, "DEFAULT "+column.Default)
+	} else if !column.Primary && (column.Nullable == nil || *column.Nullable) && !implicitDefaultNull(column.Type) {
+		attributes = append(attributes, "DEFAULT NULL")
+	}
+	if column.AutoIncrement {
+		attributes = append(attributes, "AUTO_INCREMENT")
+	}
+	if column.Other != "" {
+		attributes = append(attributes, column.Other)
+	}
+	return strings.Join(attributes, " ")
+}
+
+// implicitDefaultNull reports whether SHOW CREATE TABLE leaves out the
+// DEFAULT NULL of a nullable column of the type, as it does for TEXT and
+// BLOB columns.
+func implicitDefaultNull(columnType string) bool {
+	base := strings.ToLower(strings.SplitN(columnType, "(", 2)[0])
+	for _, suffix := range []string{"text", "blob"} {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isYAMLSchema reports whether a schema file is written in YAML, from its
+// extension.
+func isYAMLSchema(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return true
+	}
+	return false
+}